@@ -0,0 +1,56 @@
+package protobq
+
+import (
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// unmarshalAny loads an Any from a JSON column with an "@type" member,
+// or from a RECORD of the type_url STRING and value BYTES columns.
+// The packed message type is resolved and the packed value validated.
+func (o *MessageLoader) unmarshalAny(bqValue bigquery.Value) (*anypb.Any, error) {
+	resolver := o.resolver()
+	switch v := bqValue.(type) {
+	case string:
+		var result anypb.Any
+		if err := (protojson.UnmarshalOptions{Resolver: resolver}).Unmarshal([]byte(v), &result); err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid %s: %w", wktAny, err)
+		}
+		return &result, nil
+	case []bigquery.Value:
+		if len(v) != 2 {
+			return nil, newLoadError(ErrSchemaMismatch, bqValue, "%s record must have type_url and value fields", wktAny)
+		}
+		typeURL, ok := v[0].(string)
+		if !ok {
+			return nil, newLoadError(ErrTypeMismatch, v[0], "invalid type_url for %s: %#v", wktAny, v[0])
+		}
+		value, ok := v[1].([]byte)
+		if !ok && v[1] != nil {
+			return nil, newLoadError(ErrTypeMismatch, v[1], "invalid value for %s: %#v", wktAny, v[1])
+		}
+		messageType, err := resolver.FindMessageByURL(typeURL)
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, typeURL, "unable to resolve %s type %q: %w", wktAny, typeURL, err)
+		}
+		if err := (proto.UnmarshalOptions{Resolver: resolver}).Unmarshal(value, messageType.New().Interface()); err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid %s value of type %q: %w", wktAny, typeURL, err)
+		}
+		return &anypb.Any{TypeUrl: typeURL, Value: value}, nil
+	default:
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktAny, bqValue)
+	}
+}
+
+func (o *MessageLoader) resolver() interface {
+	protoregistry.MessageTypeResolver
+	protoregistry.ExtensionTypeResolver
+} {
+	if o.Resolver == nil {
+		return protoregistry.GlobalTypes
+	}
+	return o.Resolver
+}
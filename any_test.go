@@ -0,0 +1,115 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestMessageLoader_any(t *testing.T) {
+	var nested testdatav1.NestedMessage
+	nested.SetText("packed")
+	nested.SetNumber(7)
+	packed, err := anypb.New(&nested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordSchema := bigquery.Schema{
+		{Name: "type_url", Type: bigquery.StringFieldType},
+		{Name: "value", Type: bigquery.BytesFieldType},
+	}
+	for _, test := range []struct {
+		name          string
+		resolver      *protoregistry.Types
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "JSON",
+			row:    []bigquery.Value{`{"@type":"type.googleapis.com/wayplatform.testdata.v1.NestedMessage","text":"packed","number":7}`},
+			schema: bigquery.Schema{{Name: "any_value", Type: bigquery.JSONFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetAnyValue(packed)
+				return &result
+			},
+		},
+		{
+			name:   "RECORD",
+			row:    []bigquery.Value{[]bigquery.Value{packed.GetTypeUrl(), packed.GetValue()}},
+			schema: bigquery.Schema{{Name: "any_value", Type: bigquery.RecordFieldType, Schema: recordSchema}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetAnyValue(packed)
+				return &result
+			},
+		},
+		{
+			name: "repeated",
+			row: []bigquery.Value{[]bigquery.Value{
+				`{"@type":"type.googleapis.com/wayplatform.testdata.v1.NestedMessage","text":"packed","number":7}`,
+			}},
+			schema: bigquery.Schema{{Name: "repeated_any", Type: bigquery.JSONFieldType, Repeated: true}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetRepeatedAny([]*anypb.Any{packed})
+				return &result
+			},
+		},
+		{
+			name:          "JSON without @type",
+			row:           []bigquery.Value{`{"text":"packed"}`},
+			schema:        bigquery.Schema{{Name: "any_value", Type: bigquery.JSONFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unresolved type",
+			resolver:      new(protoregistry.Types),
+			row:           []bigquery.Value{[]bigquery.Value{packed.GetTypeUrl(), packed.GetValue()}},
+			schema:        bigquery.Schema{{Name: "any_value", Type: bigquery.RecordFieldType, Schema: recordSchema}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "invalid value",
+			row:           []bigquery.Value{[]bigquery.Value{packed.GetTypeUrl(), []byte{0xff}}},
+			schema:        bigquery.Schema{{Name: "any_value", Type: bigquery.RecordFieldType, Schema: recordSchema}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unsupported value",
+			row:           []bigquery.Value{int64(1)},
+			schema:        bigquery.Schema{{Name: "any_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+			if test.resolver != nil {
+				loader.Resolver = test.resolver
+			}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
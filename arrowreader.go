@@ -0,0 +1,218 @@
+package protobq
+
+import (
+	"bytes"
+	"iter"
+	"math/big"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ArrowReader loads Arrow record batches, as returned by the BigQuery Storage Read API, into proto messages.
+//
+// Arrow values are converted to the same Go types as returned by the BigQuery client,
+// and loaded with the same conversion rules as MessageLoader.
+type ArrowReader[T proto.Message] struct {
+	// Schema of the BigQuery table the record batches are read from.
+	Schema bigquery.Schema
+
+	// MessageType of the messages to load, such as a dynamicpb.MessageType.
+	// It defaults to the message type of T, and is required if T is an interface type,
+	// such as proto.Message, or *dynamicpb.Message.
+	MessageType protoreflect.MessageType
+
+	// Loader used to load each row. Its Message is replaced by a new message for each row.
+	Loader MessageLoader
+}
+
+// All returns an iterator over the rows of the record batch.
+// Iteration stops after the first error, which is yielded with a zero message.
+func (r *ArrowReader[T]) All(record arrow.Record) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if int(record.NumCols()) != len(r.Schema) {
+			yield(zero, newLoadError(
+				ErrSchemaMismatch, nil, "record has %d columns but schema has %d fields", record.NumCols(), len(r.Schema),
+			))
+			return
+		}
+		row := make([]bigquery.Value, record.NumCols())
+		for i := range int(record.NumRows()) {
+			for j, column := range record.Columns() {
+				value, err := arrowValue(column, i, r.Schema[j])
+				if err != nil {
+					yield(zero, wrapLoadError(err, r.Schema[j].Name, r.Schema[j], nil))
+					return
+				}
+				row[j] = value
+			}
+			message, err := newMessage[T](r.MessageType)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			r.Loader.Message = message
+			if err := r.Loader.Load(row, r.Schema); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(message, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Read loads all rows of the record batch into new messages.
+func (r *ArrowReader[T]) Read(record arrow.Record) ([]T, error) {
+	result := make([]T, 0, record.NumRows())
+	for message, err := range r.All(record) {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, message)
+	}
+	return result, nil
+}
+
+// arrowValue converts the i-th value of the Arrow array to the value returned by the BigQuery client.
+func arrowValue(column arrow.Array, i int, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	if column.IsNull(i) {
+		return nil, nil
+	}
+	switch column := column.(type) {
+	case *array.Boolean:
+		return column.Value(i), nil
+	case *array.Int8:
+		return int64(column.Value(i)), nil
+	case *array.Int16:
+		return int64(column.Value(i)), nil
+	case *array.Int32:
+		return int64(column.Value(i)), nil
+	case *array.Int64:
+		return column.Value(i), nil
+	case *array.Float32:
+		return float64(column.Value(i)), nil
+	case *array.Float64:
+		return column.Value(i), nil
+	case *array.Binary:
+		return bytes.Clone(column.Value(i)), nil
+	case *array.String:
+		return arrowStringValue(column.Value(i), bqFieldSchema)
+	case *array.Date32:
+		return civil.DateOf(column.Value(i).ToTime()), nil
+	case *array.Date64:
+		return civil.DateOf(column.Value(i).ToTime()), nil
+	case *array.Time32:
+		return civil.TimeOf(column.Value(i).ToTime(column.DataType().(*arrow.Time32Type).Unit)), nil
+	case *array.Time64:
+		return civil.TimeOf(column.Value(i).ToTime(column.DataType().(*arrow.Time64Type).Unit)), nil
+	case *array.Timestamp:
+		dataType := column.DataType().(*arrow.TimestampType)
+		t := column.Value(i).ToTime(dataType.Unit)
+		if dataType.TimeZone == "" {
+			return civil.DateTimeOf(t), nil
+		}
+		return t.UTC(), nil
+	case *array.Decimal128:
+		return arrowDecimalValue(column.Value(i).BigInt(), column.DataType().(*arrow.Decimal128Type).Scale), nil
+	case *array.Decimal256:
+		return arrowDecimalValue(column.Value(i).BigInt(), column.DataType().(*arrow.Decimal256Type).Scale), nil
+	case *array.MonthDayNanoInterval:
+		// The nanoseconds are split into parts with int64 arithmetic,
+		// as they overflow int32 seconds for intervals of more than 68 years.
+		value := column.Value(i)
+		seconds := value.Nanoseconds / 1e9
+		interval := &bigquery.IntervalValue{
+			Months:         value.Months,
+			Days:           value.Days,
+			Hours:          int32(seconds / secondsPerHour),
+			Minutes:        int32(seconds % secondsPerHour / secondsPerMinute),
+			Seconds:        int32(seconds % secondsPerMinute),
+			SubSecondNanos: int32(value.Nanoseconds % 1e9),
+		}
+		return interval.Canonicalize(), nil
+	case array.ListLike:
+		start, end := column.ValueOffsets(i)
+		values := column.ListValues()
+		elementSchema := *bqFieldSchema
+		elementSchema.Repeated = false
+		result := make([]bigquery.Value, 0, end-start)
+		for j := int(start); j < int(end); j++ {
+			value, err := arrowValue(values, j, &elementSchema)
+			if err != nil {
+				return nil, wrapLoadError(err, indexSegment(j-int(start)), nil, nil)
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	case *array.Struct:
+		if bqFieldSchema.Type == bigquery.RangeFieldType {
+			return arrowRangeValue(column, i, bqFieldSchema)
+		}
+		if column.NumField() != len(bqFieldSchema.Schema) {
+			return nil, newLoadError(
+				ErrSchemaMismatch, nil, "struct has %d fields but schema has %d fields", column.NumField(), len(bqFieldSchema.Schema),
+			)
+		}
+		result := make([]bigquery.Value, column.NumField())
+		for j := range column.NumField() {
+			value, err := arrowValue(column.Field(j), i, bqFieldSchema.Schema[j])
+			if err != nil {
+				return nil, wrapLoadError(err, bqFieldSchema.Schema[j].Name, bqFieldSchema.Schema[j], nil)
+			}
+			result[j] = value
+		}
+		return result, nil
+	default:
+		return nil, newLoadError(ErrUnsupportedType, nil, "unsupported Arrow type: %s", column.DataType())
+	}
+}
+
+// arrowStringValue converts an Arrow string to the value returned by the BigQuery client for the column type.
+func arrowStringValue(value string, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	switch bqFieldSchema.Type {
+	case bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		rat, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return nil, newLoadError(ErrInvalidValue, value, "invalid %s value: %q", bqFieldSchema.Type, value)
+		}
+		return rat, nil
+	case bigquery.IntervalFieldType:
+		interval, err := bigquery.ParseInterval(value)
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, value, "invalid INTERVAL value: %q: %w", value, err)
+		}
+		return interval, nil
+	default:
+		return value, nil
+	}
+}
+
+// arrowDecimalValue converts an Arrow decimal with the given scale to a *big.Rat.
+func arrowDecimalValue(unscaled *big.Int, scale int32) *big.Rat {
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	return new(big.Rat).SetFrac(unscaled, denominator)
+}
+
+// arrowRangeValue converts an Arrow struct of start and end to a *bigquery.RangeValue.
+func arrowRangeValue(column *array.Struct, i int, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	if column.NumField() != 2 || bqFieldSchema.RangeElementType == nil {
+		return nil, newLoadError(ErrSchemaMismatch, nil, "unsupported Arrow type for RANGE: %s", column.DataType())
+	}
+	elementSchema := &bigquery.FieldSchema{Type: bqFieldSchema.RangeElementType.Type}
+	start, err := arrowValue(column.Field(0), i, elementSchema)
+	if err != nil {
+		return nil, wrapLoadError(err, "start", nil, nil)
+	}
+	end, err := arrowValue(column.Field(1), i, elementSchema)
+	if err != nil {
+		return nil, wrapLoadError(err, "end", nil, nil)
+	}
+	return &bigquery.RangeValue{Start: start, End: end}, nil
+}
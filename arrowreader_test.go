@@ -0,0 +1,252 @@
+package protobq
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestArrowReader(t *testing.T) {
+	nestedType := arrow.StructOf(
+		arrow.Field{Name: "text", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "number", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	)
+	dateRangeType := arrow.StructOf(
+		arrow.Field{Name: "start", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+		arrow.Field{Name: "end", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+	)
+	mapEntryType := arrow.StructOf(
+		arrow.Field{Name: "key", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "value", Type: arrow.PrimitiveTypes.Int64},
+	)
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "string_value", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "int32_value", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "bytes_value", Type: arrow.BinaryTypes.Binary, Nullable: true},
+		{Name: "repeated_string", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+		{Name: "nested_message", Type: nestedType, Nullable: true},
+		{Name: "timestamp_value", Type: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, Nullable: true},
+		{Name: "date_value", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+		{Name: "date_range", Type: dateRangeType, Nullable: true},
+		{Name: "map_string_int32", Type: arrow.ListOf(mapEntryType)},
+	}, nil)
+	bqSchema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "int32_value", Type: bigquery.IntegerFieldType},
+		{Name: "bytes_value", Type: bigquery.BytesFieldType},
+		{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+		{
+			Name: "nested_message",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "number", Type: bigquery.IntegerFieldType},
+			},
+		},
+		{Name: "timestamp_value", Type: bigquery.TimestampFieldType},
+		{Name: "date_value", Type: bigquery.DateFieldType},
+		{
+			Name:             "date_range",
+			Type:             bigquery.RangeFieldType,
+			RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateFieldType},
+		},
+		{
+			Name:     "map_string_int32",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.IntegerFieldType},
+			},
+		},
+	}
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), arrowSchema)
+	defer builder.Release()
+	timestamp := time.Date(2024, 5, 6, 7, 8, 9, 123000, time.UTC)
+	day := arrow.Date32FromTime(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC))
+	// Row 0: all values set.
+	builder.Field(0).(*array.StringBuilder).Append("hello")
+	builder.Field(1).(*array.Int64Builder).Append(42)
+	builder.Field(2).(*array.BinaryBuilder).Append([]byte("bytes"))
+	repeatedString := builder.Field(3).(*array.ListBuilder)
+	repeatedString.Append(true)
+	repeatedString.ValueBuilder().(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+	nested := builder.Field(4).(*array.StructBuilder)
+	nested.Append(true)
+	nested.FieldBuilder(0).(*array.StringBuilder).Append("nested")
+	nested.FieldBuilder(1).(*array.Int64Builder).Append(7)
+	builder.Field(5).(*array.TimestampBuilder).Append(arrow.Timestamp(timestamp.UnixMicro()))
+	builder.Field(6).(*array.Date32Builder).Append(day)
+	dateRange := builder.Field(7).(*array.StructBuilder)
+	dateRange.Append(true)
+	dateRange.FieldBuilder(0).(*array.Date32Builder).Append(day)
+	dateRange.FieldBuilder(1).(*array.Date32Builder).AppendNull()
+	mapField := builder.Field(8).(*array.ListBuilder)
+	mapField.Append(true)
+	mapEntry := mapField.ValueBuilder().(*array.StructBuilder)
+	mapEntry.Append(true)
+	mapEntry.FieldBuilder(0).(*array.StringBuilder).Append("k")
+	mapEntry.FieldBuilder(1).(*array.Int64Builder).Append(3)
+	// Row 1: nulls and empty lists.
+	for i := range 3 {
+		builder.Field(i).AppendNull()
+	}
+	repeatedString.Append(true)
+	nested.AppendNull()
+	nested.FieldBuilder(0).AppendNull()
+	nested.FieldBuilder(1).AppendNull()
+	builder.Field(5).AppendNull()
+	builder.Field(6).AppendNull()
+	dateRange.AppendNull()
+	dateRange.FieldBuilder(0).AppendNull()
+	dateRange.FieldBuilder(1).AppendNull()
+	mapField.Append(true)
+	record := builder.NewRecord()
+	defer record.Release()
+
+	reader := ArrowReader[*testdatav1.KitchenSink]{Schema: bqSchema}
+	actual, err := reader.Read(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var first testdatav1.KitchenSink
+	first.SetStringValue("hello")
+	first.SetInt32Value(42)
+	first.SetBytesValue([]byte("bytes"))
+	first.SetRepeatedString([]string{"a", "b"})
+	var nestedMessage testdatav1.NestedMessage
+	nestedMessage.SetText("nested")
+	nestedMessage.SetNumber(7)
+	first.SetNestedMessage(&nestedMessage)
+	first.SetTimestampValue(timestamppb.New(timestamp))
+	first.SetDateValue(&date.Date{Year: 2024, Month: 5, Day: 6})
+	first.SetDateRange(newDateRange("2024-05-06", ""))
+	first.SetMapStringInt32(map[string]int32{"k": 3})
+	expected := []*testdatav1.KitchenSink{&first, {}}
+	if diff := cmp.Diff(expected, actual, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestArrowReader_errors(t *testing.T) {
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "repeated_int32", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), arrowSchema)
+	defer builder.Release()
+	list := builder.Field(0).(*array.ListBuilder)
+	list.Append(true)
+	list.ValueBuilder().(*array.StringBuilder).AppendValues([]string{"1", "two"}, nil)
+	record := builder.NewRecord()
+	defer record.Release()
+	t.Run("schema mismatch", func(t *testing.T) {
+		reader := ArrowReader[*testdatav1.KitchenSink]{}
+		if _, err := reader.Read(record); !errors.Is(err, ErrSchemaMismatch) {
+			t.Errorf("expected schema mismatch, got %v", err)
+		}
+	})
+	t.Run("load error", func(t *testing.T) {
+		reader := ArrowReader[*testdatav1.KitchenSink]{
+			Schema: bigquery.Schema{
+				{Name: "repeated_int32", Type: bigquery.StringFieldType, Repeated: true},
+			},
+		}
+		_, err := reader.Read(record)
+		var loadErr *LoadError
+		if !errors.As(err, &loadErr) || loadErr.Path != "repeated_int32[0]" {
+			t.Errorf("expected load error at repeated_int32[0], got %v", err)
+		}
+	})
+}
+
+func TestArrowReader_messageType(t *testing.T) {
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "repeated_string", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), arrowSchema)
+	defer builder.Release()
+	list := builder.Field(0).(*array.ListBuilder)
+	list.Append(true)
+	list.ValueBuilder().(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+	record := builder.NewRecord()
+	defer record.Release()
+	bqSchema := bigquery.Schema{{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true}}
+	descriptor := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	t.Run("dynamic message", func(t *testing.T) {
+		reader := ArrowReader[*dynamicpb.Message]{Schema: bqSchema, MessageType: dynamicpb.NewMessageType(descriptor)}
+		actual, err := reader.Read(record)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.KitchenSink
+		expected.SetRepeatedString([]string{"a", "b"})
+		if diff := cmp.Diff([]proto.Message{&expected}, []proto.Message{actual[0]}, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("missing message type", func(t *testing.T) {
+		reader := ArrowReader[proto.Message]{Schema: bqSchema}
+		if _, err := reader.Read(record); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("expected %v, got %v", ErrUnsupportedType, err)
+		}
+	})
+}
+
+func TestArrowValue_monthDayNanoInterval(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		value    arrow.MonthDayNanoInterval
+		expected *bigquery.IntervalValue
+	}{
+		{
+			name:  "parts",
+			value: arrow.MonthDayNanoInterval{Months: 14, Days: 3, Nanoseconds: 90061_000000001},
+			expected: &bigquery.IntervalValue{
+				Years: 1, Months: 2, Days: 3, Hours: 25, Minutes: 1, Seconds: 1, SubSecondNanos: 1,
+			},
+		},
+		{
+			// BigQuery allows 87,840,000 hours, beyond the range of int64 nanoseconds.
+			name:  "maximum",
+			value: arrow.MonthDayNanoInterval{Months: 120000, Days: 3660000, Nanoseconds: math.MaxInt64},
+			expected: &bigquery.IntervalValue{
+				Years: 10000, Days: 3660000, Hours: 2562047, Minutes: 47, Seconds: 16, SubSecondNanos: 854775807,
+			},
+		},
+		{
+			name:  "minimum",
+			value: arrow.MonthDayNanoInterval{Months: -120000, Days: -3660000, Nanoseconds: math.MinInt64},
+			expected: &bigquery.IntervalValue{
+				Years: -10000, Days: -3660000, Hours: -2562047, Minutes: -47, Seconds: -16, SubSecondNanos: -854775808,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			builder := array.NewMonthDayNanoIntervalBuilder(memory.NewGoAllocator())
+			defer builder.Release()
+			builder.Append(test.value)
+			column := builder.NewArray()
+			defer column.Release()
+			actual, err := arrowValue(column, 0, &bigquery.FieldSchema{Name: "interval", Type: bigquery.IntervalFieldType})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("unexpected interval (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package protobq
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"iter"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AvroReader loads Avro rows, as returned by the BigQuery Storage Read API, into proto messages.
+//
+// Avro values are converted to the same Go types as returned by the BigQuery client,
+// and loaded with the same conversion rules as MessageLoader.
+type AvroReader[T proto.Message] struct {
+	// Schema of the BigQuery table the rows are read from.
+	Schema bigquery.Schema
+
+	// AvroSchema is the JSON Avro schema of the read session.
+	AvroSchema string
+
+	// MessageType of the messages to load, such as a dynamicpb.MessageType.
+	// It defaults to the message type of T, and is required if T is an interface type,
+	// such as proto.Message, or *dynamicpb.Message.
+	MessageType protoreflect.MessageType
+
+	// Loader used to load each row. Its Message is replaced by a new message for each row.
+	Loader MessageLoader
+}
+
+// All returns an iterator over the rows in the serialized binary rows of a read response.
+// Iteration stops after the first error, which is yielded with a zero message.
+func (r *AvroReader[T]) All(rows []byte) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		schema, err := parseAvroSchema(r.AvroSchema)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		if schema.Type != "record" || len(schema.Fields) != len(r.Schema) {
+			yield(zero, newLoadError(
+				ErrSchemaMismatch, nil, "Avro schema has %d fields but schema has %d fields", len(schema.Fields), len(r.Schema),
+			))
+			return
+		}
+		decoder := avroDecoder{data: rows}
+		row := make([]bigquery.Value, len(schema.Fields))
+		for decoder.pos < len(decoder.data) {
+			for j, field := range schema.Fields {
+				value, err := decoder.value(field.Type, r.Schema[j])
+				if err != nil {
+					yield(zero, wrapLoadError(err, r.Schema[j].Name, r.Schema[j], nil))
+					return
+				}
+				row[j] = value
+			}
+			message, err := newMessage[T](r.MessageType)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			r.Loader.Message = message
+			if err := r.Loader.Load(row, r.Schema); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(message, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Read loads all serialized binary rows of a read response into new messages.
+func (r *AvroReader[T]) Read(rows []byte) ([]T, error) {
+	var result []T
+	for message, err := range r.All(rows) {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, message)
+	}
+	return result, nil
+}
+
+// avroSchema is the subset of an Avro schema used by the BigQuery Storage Read API.
+type avroSchema struct {
+	Type        string
+	LogicalType string
+	Scale       int
+	Size        int
+	Fields      []*avroField
+	Items       *avroSchema
+	Branches    []*avroSchema
+	Symbols     []string
+}
+
+type avroField struct {
+	Name string
+	Type *avroSchema
+}
+
+// parseAvroSchema parses a JSON Avro schema.
+func parseAvroSchema(s string) (*avroSchema, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, newLoadError(ErrSchemaMismatch, nil, "invalid Avro schema: %w", err)
+	}
+	return newAvroSchema(raw, map[string]*avroSchema{})
+}
+
+func newAvroSchema(raw any, named map[string]*avroSchema) (*avroSchema, error) {
+	switch raw := raw.(type) {
+	case string:
+		switch raw {
+		case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+			return &avroSchema{Type: raw}, nil
+		}
+		if schema, ok := named[raw]; ok {
+			return schema, nil
+		}
+		return nil, newLoadError(ErrUnsupportedType, nil, "unsupported Avro type: %q", raw)
+	case []any:
+		schema := &avroSchema{Type: "union"}
+		for _, branch := range raw {
+			branchSchema, err := newAvroSchema(branch, named)
+			if err != nil {
+				return nil, err
+			}
+			schema.Branches = append(schema.Branches, branchSchema)
+		}
+		return schema, nil
+	case map[string]any:
+		var schema *avroSchema
+		switch t := raw["type"].(type) {
+		case string:
+			switch t {
+			case "record", "array", "fixed", "enum":
+				schema = &avroSchema{Type: t}
+			default:
+				base, err := newAvroSchema(t, named)
+				if err != nil {
+					return nil, err
+				}
+				copied := *base
+				schema = &copied
+			}
+		default:
+			return newAvroSchema(t, named)
+		}
+		if logicalType, ok := raw["logicalType"].(string); ok {
+			schema.LogicalType = logicalType
+		}
+		if scale, ok := raw["scale"].(float64); ok {
+			schema.Scale = int(scale)
+		}
+		if size, ok := raw["size"].(float64); ok {
+			schema.Size = int(size)
+		}
+		if name, ok := raw["name"].(string); ok && (schema.Type == "record" || schema.Type == "fixed" || schema.Type == "enum") {
+			named[name] = schema
+		}
+		switch schema.Type {
+		case "record":
+			fields, _ := raw["fields"].([]any)
+			for _, field := range fields {
+				field, _ := field.(map[string]any)
+				name, _ := field["name"].(string)
+				fieldSchema, err := newAvroSchema(field["type"], named)
+				if err != nil {
+					return nil, wrapLoadError(err, name, nil, nil)
+				}
+				schema.Fields = append(schema.Fields, &avroField{Name: name, Type: fieldSchema})
+			}
+		case "array":
+			items, err := newAvroSchema(raw["items"], named)
+			if err != nil {
+				return nil, err
+			}
+			schema.Items = items
+		case "enum":
+			symbols, _ := raw["symbols"].([]any)
+			for _, symbol := range symbols {
+				symbol, _ := symbol.(string)
+				schema.Symbols = append(schema.Symbols, symbol)
+			}
+		}
+		return schema, nil
+	default:
+		return nil, newLoadError(ErrSchemaMismatch, nil, "invalid Avro schema: %v", raw)
+	}
+}
+
+// avroDecoder decodes values in the Avro binary encoding.
+type avroDecoder struct {
+	data []byte
+	pos  int
+}
+
+// value decodes a value of the Avro schema to the value returned by the BigQuery client.
+func (d *avroDecoder) value(schema *avroSchema, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	switch schema.Type {
+	case "null":
+		return nil, nil
+	case "boolean":
+		b, err := d.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "int", "long":
+		value, err := d.long()
+		if err != nil {
+			return nil, err
+		}
+		return avroLongValue(value, schema.LogicalType), nil
+	case "float":
+		b, err := d.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+	case "double":
+		b, err := d.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case "bytes", "fixed":
+		var b []byte
+		var err error
+		if schema.Type == "fixed" {
+			b, err = d.next(schema.Size)
+		} else {
+			b, err = d.bytes()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if schema.LogicalType == "decimal" {
+			return avroDecimalValue(b, schema.Scale), nil
+		}
+		return bytes.Clone(b), nil
+	case "string":
+		b, err := d.bytes()
+		if err != nil {
+			return nil, err
+		}
+		return avroStringValue(string(b), bqFieldSchema)
+	case "enum":
+		i, err := d.long()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(schema.Symbols) {
+			return nil, newLoadError(ErrInvalidValue, i, "invalid Avro enum index: %d", i)
+		}
+		return schema.Symbols[i], nil
+	case "union":
+		i, err := d.long()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(schema.Branches) {
+			return nil, newLoadError(ErrInvalidValue, i, "invalid Avro union index: %d", i)
+		}
+		return d.value(schema.Branches[i], bqFieldSchema)
+	case "array":
+		elementSchema := *bqFieldSchema
+		elementSchema.Repeated = false
+		result := []bigquery.Value{}
+		for {
+			count, err := d.blockCount()
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return result, nil
+			}
+			result = slices.Grow(result, count)
+			for range count {
+				pos := d.pos
+				value, err := d.value(schema.Items, &elementSchema)
+				if err != nil {
+					return nil, wrapLoadError(err, indexSegment(len(result)), nil, nil)
+				}
+				if d.pos == pos {
+					// Elements of BigQuery values take at least one byte, which bounds the number of elements.
+					return nil, newLoadError(ErrInvalidValue, nil, "invalid Avro data: empty array element")
+				}
+				result = append(result, value)
+			}
+		}
+	case "record":
+		if bqFieldSchema.Type == bigquery.RangeFieldType {
+			return d.rangeValue(schema, bqFieldSchema)
+		}
+		if len(schema.Fields) != len(bqFieldSchema.Schema) {
+			return nil, newLoadError(
+				ErrSchemaMismatch, nil, "record has %d fields but schema has %d fields", len(schema.Fields), len(bqFieldSchema.Schema),
+			)
+		}
+		result := make([]bigquery.Value, len(schema.Fields))
+		for j, field := range schema.Fields {
+			value, err := d.value(field.Type, bqFieldSchema.Schema[j])
+			if err != nil {
+				return nil, wrapLoadError(err, bqFieldSchema.Schema[j].Name, bqFieldSchema.Schema[j], nil)
+			}
+			result[j] = value
+		}
+		return result, nil
+	default:
+		return nil, newLoadError(ErrUnsupportedType, nil, "unsupported Avro type: %s", schema.Type)
+	}
+}
+
+// rangeValue decodes an Avro record of start and end to a *bigquery.RangeValue.
+func (d *avroDecoder) rangeValue(schema *avroSchema, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	if len(schema.Fields) != 2 || bqFieldSchema.RangeElementType == nil {
+		return nil, newLoadError(ErrSchemaMismatch, nil, "unsupported Avro record for RANGE")
+	}
+	elementSchema := &bigquery.FieldSchema{Type: bqFieldSchema.RangeElementType.Type}
+	start, err := d.value(schema.Fields[0].Type, elementSchema)
+	if err != nil {
+		return nil, wrapLoadError(err, "start", nil, nil)
+	}
+	end, err := d.value(schema.Fields[1].Type, elementSchema)
+	if err != nil {
+		return nil, wrapLoadError(err, "end", nil, nil)
+	}
+	return &bigquery.RangeValue{Start: start, End: end}, nil
+}
+
+// long decodes a zig-zag encoded variable-length integer.
+func (d *avroDecoder) long() (int64, error) {
+	value, n := binary.Varint(d.data[d.pos:])
+	if n <= 0 {
+		return 0, newLoadError(ErrInvalidValue, nil, "invalid Avro data: truncated or overflowing integer")
+	}
+	d.pos += n
+	return value, nil
+}
+
+// blockCount decodes the number of items of the next block of an array.
+// The count is validated against the remaining data, as each item takes at least one byte,
+// so that a corrupt count does not exhaust memory.
+func (d *avroDecoder) blockCount() (int, error) {
+	count, err := d.long()
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		// A negative count is followed by the size of the block in bytes.
+		if count == math.MinInt64 {
+			return 0, newLoadError(ErrInvalidValue, nil, "invalid Avro data: block count overflows")
+		}
+		count = -count
+		if _, err := d.long(); err != nil {
+			return 0, err
+		}
+	}
+	if count > int64(len(d.data)-d.pos) {
+		return 0, newLoadError(
+			ErrInvalidValue, nil, "invalid Avro data: block of %d items exceeds the %d remaining bytes", count, len(d.data)-d.pos,
+		)
+	}
+	return int(count), nil
+}
+
+// bytes decodes a length-prefixed byte sequence, without copying.
+func (d *avroDecoder) bytes() ([]byte, error) {
+	n, err := d.long()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, newLoadError(ErrInvalidValue, nil, "invalid Avro data: negative length %d", n)
+	}
+	return d.next(int(n))
+}
+
+// next returns the next n bytes, without copying.
+func (d *avroDecoder) next(n int) ([]byte, error) {
+	if n > len(d.data)-d.pos {
+		return nil, newLoadError(ErrInvalidValue, nil, "invalid Avro data: unexpected end of data")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+// avroLongValue converts an Avro int or long with the given logical type to the value returned by the BigQuery client.
+func avroLongValue(value int64, logicalType string) bigquery.Value {
+	switch logicalType {
+	case "date":
+		return civil.DateOf(time.Unix(value*24*60*60, 0).UTC())
+	case "time-micros":
+		return civil.TimeOf(time.UnixMicro(value).UTC())
+	case "time-millis":
+		return civil.TimeOf(time.UnixMilli(value).UTC())
+	case "timestamp-micros":
+		return time.UnixMicro(value).UTC()
+	case "timestamp-millis":
+		return time.UnixMilli(value).UTC()
+	case "local-timestamp-micros":
+		return civil.DateTimeOf(time.UnixMicro(value).UTC())
+	case "local-timestamp-millis":
+		return civil.DateTimeOf(time.UnixMilli(value).UTC())
+	default:
+		return value
+	}
+}
+
+// avroStringValue converts an Avro string to the value returned by the BigQuery client for the column type.
+func avroStringValue(value string, bqFieldSchema *bigquery.FieldSchema) (bigquery.Value, error) {
+	if bqFieldSchema.Type == bigquery.DateTimeFieldType {
+		dateTime, err := civil.ParseDateTime(strings.Replace(value, " ", "T", 1))
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, value, "invalid DATETIME value: %q: %w", value, err)
+		}
+		return dateTime, nil
+	}
+	return arrowStringValue(value, bqFieldSchema)
+}
+
+// avroDecimalValue converts an Avro decimal, a big-endian two's-complement unscaled integer, to a *big.Rat.
+func avroDecimalValue(b []byte, scale int) *big.Rat {
+	unscaled := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return arrowDecimalValue(unscaled, int32(scale))
+}
@@ -0,0 +1,269 @@
+package protobq
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// avroEncoder builds Avro binary encoded rows for tests.
+type avroEncoder []byte
+
+func (e *avroEncoder) long(v int64) *avroEncoder {
+	*e = binary.AppendVarint(*e, v)
+	return e
+}
+
+func (e *avroEncoder) double(v float64) *avroEncoder {
+	*e = binary.LittleEndian.AppendUint64(*e, math.Float64bits(v))
+	return e
+}
+
+func (e *avroEncoder) bytes(v []byte) *avroEncoder {
+	e.long(int64(len(v)))
+	*e = append(*e, v...)
+	return e
+}
+
+func (e *avroEncoder) string(v string) *avroEncoder {
+	return e.bytes([]byte(v))
+}
+
+func TestAvroReader(t *testing.T) {
+	avroSchema := `{
+		"type": "record",
+		"name": "__root__",
+		"fields": [
+			{"name": "string_value", "type": ["null", "string"]},
+			{"name": "int32_value", "type": ["null", "long"]},
+			{"name": "double_value", "type": ["null", "double"]},
+			{"name": "bytes_value", "type": ["null", "bytes"]},
+			{"name": "repeated_string", "type": {"type": "array", "items": "string"}},
+			{"name": "nested_message", "type": ["null", {
+				"type": "record",
+				"name": "nested_message",
+				"fields": [
+					{"name": "text", "type": ["null", "string"]},
+					{"name": "number", "type": ["null", "long"]}
+				]
+			}]},
+			{"name": "timestamp_value", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}]},
+			{"name": "date_value", "type": ["null", {"type": "int", "logicalType": "date"}]},
+			{"name": "datetime_value", "type": ["null", {"type": "string", "logicalType": "datetime"}]},
+			{"name": "geography_string", "type": ["null", {"type": "string", "sqlType": "GEOGRAPHY"}]},
+			{"name": "map_string_int32", "type": {"type": "array", "items": {
+				"type": "record",
+				"name": "map_string_int32",
+				"fields": [
+					{"name": "key", "type": "string"},
+					{"name": "value", "type": "long"}
+				]
+			}}}
+		]
+	}`
+	bqSchema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "int32_value", Type: bigquery.IntegerFieldType},
+		{Name: "double_value", Type: bigquery.FloatFieldType},
+		{Name: "bytes_value", Type: bigquery.BytesFieldType},
+		{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+		{
+			Name: "nested_message",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "number", Type: bigquery.IntegerFieldType},
+			},
+		},
+		{Name: "timestamp_value", Type: bigquery.TimestampFieldType},
+		{Name: "date_value", Type: bigquery.DateFieldType},
+		{Name: "datetime_value", Type: bigquery.DateTimeFieldType},
+		{Name: "geography_string", Type: bigquery.GeographyFieldType},
+		{
+			Name:     "map_string_int32",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.IntegerFieldType},
+			},
+		},
+	}
+	timestamp := time.Date(2024, 5, 6, 7, 8, 9, 123000, time.UTC)
+	var rows avroEncoder
+	// Row 0: all values set.
+	rows.long(1).string("hello")
+	rows.long(1).long(42)
+	rows.long(1).double(1.5)
+	rows.long(1).bytes([]byte("bytes"))
+	rows.long(2).string("a").string("b").long(0)
+	rows.long(1).long(1).string("nested").long(1).long(7)
+	rows.long(1).long(timestamp.UnixMicro())
+	rows.long(1).long(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+	rows.long(1).string("2024-05-06T07:08:09.5")
+	rows.long(1).string("POINT(1 2)")
+	// A block with a negative count is followed by its size in bytes.
+	rows.long(-1).long(3).string("k").long(3).long(0)
+	// Row 1: nulls and empty arrays.
+	for range 11 {
+		rows.long(0)
+	}
+
+	reader := AvroReader[*testdatav1.KitchenSink]{Schema: bqSchema, AvroSchema: avroSchema}
+	actual, err := reader.Read(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var first testdatav1.KitchenSink
+	first.SetStringValue("hello")
+	first.SetInt32Value(42)
+	first.SetDoubleValue(1.5)
+	first.SetBytesValue([]byte("bytes"))
+	first.SetRepeatedString([]string{"a", "b"})
+	var nestedMessage testdatav1.NestedMessage
+	nestedMessage.SetText("nested")
+	nestedMessage.SetNumber(7)
+	first.SetNestedMessage(&nestedMessage)
+	first.SetTimestampValue(timestamppb.New(timestamp))
+	first.SetDateValue(&date.Date{Year: 2024, Month: 5, Day: 6})
+	first.SetDatetimeValue(&datetime.DateTime{Year: 2024, Month: 5, Day: 6, Hours: 7, Minutes: 8, Seconds: 9, Nanos: 500000000})
+	first.SetGeographyString("POINT(1 2)")
+	first.SetMapStringInt32(map[string]int32{"k": 3})
+	expected := []*testdatav1.KitchenSink{&first, {}}
+	if diff := cmp.Diff(expected, actual, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestAvroDecimalValue(t *testing.T) {
+	for _, tt := range []struct {
+		bytes    []byte
+		scale    int
+		expected string
+	}{
+		{bytes: nil, scale: 9, expected: "0"},
+		{bytes: []byte{0x59, 0x68, 0x2f, 0x00}, scale: 9, expected: "3/2"},
+		// -1.5 with scale 9 is -1500000000, 0xA697D100 in two's complement.
+		{bytes: []byte{0xa6, 0x97, 0xd1, 0x00}, scale: 9, expected: "-3/2"},
+		{bytes: []byte{0xff}, scale: 0, expected: "-1"},
+	} {
+		if actual := avroDecimalValue(tt.bytes, tt.scale); actual.RatString() != tt.expected {
+			t.Errorf("avroDecimalValue(%x, %d) = %v, expected %s", tt.bytes, tt.scale, actual, tt.expected)
+		}
+	}
+}
+
+func TestAvroReader_errors(t *testing.T) {
+	avroSchema := `{
+		"type": "record",
+		"name": "__root__",
+		"fields": [{"name": "repeated_int32", "type": {"type": "array", "items": "string"}}]
+	}`
+	var rows avroEncoder
+	rows.long(2).string("1").string("two").long(0)
+	t.Run("schema mismatch", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{AvroSchema: avroSchema}
+		if _, err := reader.Read(rows); !errors.Is(err, ErrSchemaMismatch) {
+			t.Errorf("expected schema mismatch, got %v", err)
+		}
+	})
+	t.Run("invalid schema", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{AvroSchema: "{"}
+		if _, err := reader.Read(rows); !errors.Is(err, ErrSchemaMismatch) {
+			t.Errorf("expected schema mismatch, got %v", err)
+		}
+	})
+	bqSchema := bigquery.Schema{
+		{Name: "repeated_int32", Type: bigquery.StringFieldType, Repeated: true},
+	}
+	t.Run("load error", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{Schema: bqSchema, AvroSchema: avroSchema}
+		_, err := reader.Read(rows)
+		var loadErr *LoadError
+		if !errors.As(err, &loadErr) || loadErr.Path != "repeated_int32[0]" {
+			t.Errorf("expected load error at repeated_int32[0], got %v", err)
+		}
+	})
+	t.Run("block count exceeding data", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{Schema: bqSchema, AvroSchema: avroSchema}
+		for _, count := range []int64{math.MaxInt64, math.MinInt64, -1 << 40} {
+			var rows avroEncoder
+			rows.long(count).long(1).string("1")
+			_, err := reader.Read(rows)
+			if !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("expected invalid value for count %d, got %v", count, err)
+			}
+		}
+	})
+	t.Run("empty array elements", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{
+			Schema: bqSchema,
+			AvroSchema: `{
+				"type": "record",
+				"name": "__root__",
+				"fields": [{"name": "repeated_int32", "type": {"type": "array", "items": "null"}}]
+			}`,
+		}
+		var rows avroEncoder
+		for range 8 {
+			rows.long(8)
+		}
+		if _, err := reader.Read(rows); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("expected invalid value, got %v", err)
+		}
+	})
+	t.Run("truncated data", func(t *testing.T) {
+		reader := AvroReader[*testdatav1.KitchenSink]{Schema: bqSchema, AvroSchema: avroSchema}
+		_, err := reader.Read(rows[:len(rows)-2])
+		var loadErr *LoadError
+		if !errors.As(err, &loadErr) || !errors.Is(err, ErrInvalidValue) || loadErr.Path != "repeated_int32[1]" {
+			t.Errorf("expected invalid value at repeated_int32[1], got %v", err)
+		}
+	})
+}
+
+func TestAvroReader_messageType(t *testing.T) {
+	avroSchema := `{
+		"type": "record",
+		"name": "__root__",
+		"fields": [{"name": "repeated_string", "type": {"type": "array", "items": "string"}}]
+	}`
+	var rows avroEncoder
+	rows.long(2).string("a").string("b").long(0)
+	bqSchema := bigquery.Schema{{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true}}
+	descriptor := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	t.Run("dynamic message", func(t *testing.T) {
+		reader := AvroReader[*dynamicpb.Message]{
+			Schema:      bqSchema,
+			AvroSchema:  avroSchema,
+			MessageType: dynamicpb.NewMessageType(descriptor),
+		}
+		actual, err := reader.Read(rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.KitchenSink
+		expected.SetRepeatedString([]string{"a", "b"})
+		if diff := cmp.Diff([]proto.Message{&expected}, []proto.Message{actual[0]}, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("missing message type", func(t *testing.T) {
+		reader := AvroReader[proto.Message]{Schema: bqSchema, AvroSchema: avroSchema}
+		if _, err := reader.Read(rows); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("expected %v, got %v", ErrUnsupportedType, err)
+		}
+	})
+}
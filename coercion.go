@@ -0,0 +1,266 @@
+package protobq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CoercionMode controls how MessageLoader converts BigQuery values into scalar fields
+// and wrapper fields of another type or range.
+type CoercionMode int
+
+const (
+	// CoercionTruncate converts values as Go conversions do: integers wrap around to the range
+	// of the field, and FLOAT64 values are rounded to float. This is the default.
+	CoercionTruncate CoercionMode = iota
+	// CoercionStrict returns ErrOutOfRange for values outside the range of the field,
+	// such as negative integers loaded into unsigned fields, and ErrPrecisionLoss for
+	// FLOAT64 values that can not be represented exactly as float.
+	CoercionStrict
+	// CoercionLenient checks values like CoercionStrict, and also converts between types:
+	//   - strings into numeric and bool fields, as parsed by the strconv package;
+	//   - numbers and bools into string fields, as formatted by the strconv package;
+	//   - the integers 0 and 1 into bool fields;
+	//   - integers into floating-point fields, and integral FLOAT64 values into integer fields,
+	//     if they can be represented exactly.
+	//
+	// Strings that can not be parsed fail with ErrInvalidValue.
+	// MessageLoader.CheckCompatibility considers these conversions for loaders in this mode.
+	CoercionLenient
+)
+
+// String returns a string representation of the coercion mode.
+func (m CoercionMode) String() string {
+	switch m {
+	case CoercionTruncate:
+		return "truncate"
+	case CoercionStrict:
+		return "strict"
+	case CoercionLenient:
+		return "lenient"
+	default:
+		return fmt.Sprintf("CoercionMode(%d)", int(m))
+	}
+}
+
+// coerceScalar converts a value, as returned by the BigQuery client, into a scalar of the given kind,
+// according to the CoercionMode. It returns false if the value can not be converted into the kind.
+func (o *MessageLoader) coerceScalar(bqValue bigquery.Value, kind protoreflect.Kind) (protoreflect.Value, bool, error) {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, ok, err := o.coerceInt64(bqValue)
+		if !ok || err != nil {
+			return protoreflect.Value{}, ok, err
+		}
+		if o.CoercionMode != CoercionTruncate && (n < math.MinInt32 || n > math.MaxInt32) {
+			return protoreflect.Value{}, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows %v", bqValue, kind)
+		}
+		return protoreflect.ValueOfInt32(int32(n)), true, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, ok, err := o.coerceInt64(bqValue)
+		return protoreflect.ValueOfInt64(n), ok, err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, ok, err := o.coerceUint64(bqValue)
+		if !ok || err != nil {
+			return protoreflect.Value{}, ok, err
+		}
+		if o.CoercionMode != CoercionTruncate && n > math.MaxUint32 {
+			return protoreflect.Value{}, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows %v", bqValue, kind)
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), true, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, ok, err := o.coerceUint64(bqValue)
+		return protoreflect.ValueOfUint64(n), ok, err
+	case protoreflect.FloatKind:
+		f, ok, err := o.coerceFloat64(bqValue)
+		if !ok || err != nil {
+			return protoreflect.Value{}, ok, err
+		}
+		if o.CoercionMode != CoercionTruncate && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			if math.Abs(f) > math.MaxFloat32 {
+				return protoreflect.Value{}, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows %v", bqValue, kind)
+			}
+			if float64(float32(f)) != f {
+				return protoreflect.Value{}, true, newLoadError(ErrPrecisionLoss, bqValue, "%v can not be represented as %v", bqValue, kind)
+			}
+		}
+		return protoreflect.ValueOfFloat32(float32(f)), true, nil
+	case protoreflect.DoubleKind:
+		f, ok, err := o.coerceFloat64(bqValue)
+		return protoreflect.ValueOfFloat64(f), ok, err
+	case protoreflect.BoolKind:
+		b, ok, err := o.coerceBool(bqValue)
+		return protoreflect.ValueOfBool(b), ok, err
+	case protoreflect.StringKind:
+		s, ok := o.coerceString(bqValue)
+		return protoreflect.ValueOfString(s), ok, nil
+	default:
+		return protoreflect.Value{}, false, nil
+	}
+}
+
+func (o *MessageLoader) coerceInt64(bqValue bigquery.Value) (int64, bool, error) {
+	switch v := bqValue.(type) {
+	case int64:
+		return v, true, nil
+	case int32:
+		return int64(v), true, nil
+	case uint32:
+		return int64(v), true, nil
+	case uint64:
+		if o.CoercionMode != CoercionTruncate && v > math.MaxInt64 {
+			return 0, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows int64", bqValue)
+		}
+		return int64(v), true, nil
+	}
+	if o.CoercionMode != CoercionLenient {
+		return 0, false, nil
+	}
+	switch v := bqValue.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, true, newLoadError(ErrPrecisionLoss, bqValue, "%v is not an integer", bqValue)
+		}
+		// float64(math.MaxInt64) rounds up to 2^63.
+		if v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows int64", bqValue)
+		}
+		return int64(v), true, nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, true, parseError(bqValue, err)
+		}
+		return n, true, nil
+	}
+	return 0, false, nil
+}
+
+func (o *MessageLoader) coerceUint64(bqValue bigquery.Value) (uint64, bool, error) {
+	switch v := bqValue.(type) {
+	case uint64:
+		return v, true, nil
+	case uint32:
+		return uint64(v), true, nil
+	case int64, int32:
+		n, _, _ := o.coerceInt64(v)
+		if o.CoercionMode != CoercionTruncate && n < 0 {
+			return 0, true, newLoadError(ErrOutOfRange, bqValue, "%v is negative", bqValue)
+		}
+		return uint64(n), true, nil
+	}
+	if o.CoercionMode != CoercionLenient {
+		return 0, false, nil
+	}
+	switch v := bqValue.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, true, newLoadError(ErrPrecisionLoss, bqValue, "%v is not an integer", bqValue)
+		}
+		// float64(math.MaxUint64) rounds up to 2^64.
+		if v < 0 || v >= math.MaxUint64 {
+			return 0, true, newLoadError(ErrOutOfRange, bqValue, "%v overflows uint64", bqValue)
+		}
+		return uint64(v), true, nil
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, true, parseError(bqValue, err)
+		}
+		return n, true, nil
+	}
+	return 0, false, nil
+}
+
+func (o *MessageLoader) coerceFloat64(bqValue bigquery.Value) (float64, bool, error) {
+	switch v := bqValue.(type) {
+	case float64:
+		return v, true, nil
+	case float32:
+		return float64(v), true, nil
+	}
+	if o.CoercionMode != CoercionLenient {
+		return 0, false, nil
+	}
+	var f float64
+	var exact bool
+	switch v := bqValue.(type) {
+	case int64:
+		// float64(v) may round up to 2^63, which overflows int64.
+		f = float64(v)
+		exact = f < math.MaxInt64 && int64(f) == v
+	case int32:
+		f, exact = float64(v), true
+	case uint32:
+		f, exact = float64(v), true
+	case uint64:
+		f = float64(v)
+		exact = f < math.MaxUint64 && uint64(f) == v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, true, parseError(bqValue, err)
+		}
+		return f, true, nil
+	default:
+		return 0, false, nil
+	}
+	if !exact {
+		return 0, true, newLoadError(ErrPrecisionLoss, bqValue, "%v can not be represented as double", bqValue)
+	}
+	return f, true, nil
+}
+
+func (o *MessageLoader) coerceBool(bqValue bigquery.Value) (bool, bool, error) {
+	if b, ok := bqValue.(bool); ok {
+		return b, true, nil
+	}
+	if o.CoercionMode != CoercionLenient {
+		return false, false, nil
+	}
+	switch v := bqValue.(type) {
+	case int64:
+		if v != 0 && v != 1 {
+			return false, true, newLoadError(ErrInvalidValue, bqValue, "%v is not a bool", bqValue)
+		}
+		return v == 1, true, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, true, parseError(bqValue, err)
+		}
+		return b, true, nil
+	}
+	return false, false, nil
+}
+
+func (o *MessageLoader) coerceString(bqValue bigquery.Value) (string, bool) {
+	if s, ok := bqValue.(string); ok {
+		return s, true
+	}
+	if o.CoercionMode != CoercionLenient {
+		return "", false
+	}
+	switch v := bqValue.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// parseError converts an error of the strconv package into a LoadError.
+func parseError(bqValue bigquery.Value, err error) *LoadError {
+	if errors.Is(err, strconv.ErrRange) {
+		return newLoadError(ErrOutOfRange, bqValue, "%w", err)
+	}
+	return newLoadError(ErrInvalidValue, bqValue, "%w", err)
+}
@@ -0,0 +1,348 @@
+package protobq
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMessageLoader_coercion(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		mode          CoercionMode
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "truncated int32",
+			row:    []bigquery.Value{int64(1<<32 + 1)},
+			schema: bigquery.Schema{{Name: "int32_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt32Value(1)
+				return &result
+			},
+		},
+		{
+			name:   "truncated uint32",
+			row:    []bigquery.Value{int64(-1)},
+			schema: bigquery.Schema{{Name: "uint32_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint32Value(math.MaxUint32)
+				return &result
+			},
+		},
+		{
+			name:   "strict int32",
+			mode:   CoercionStrict,
+			row:    []bigquery.Value{int64(math.MinInt32)},
+			schema: bigquery.Schema{{Name: "sint32_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetSint32Value(math.MinInt32)
+				return &result
+			},
+		},
+		{
+			name:          "strict int32 overflow",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(1<<32 + 1)},
+			schema:        bigquery.Schema{{Name: "int32_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "strict negative uint32",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(-1)},
+			schema:        bigquery.Schema{{Name: "fixed32_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "strict negative uint64",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(-1)},
+			schema:        bigquery.Schema{{Name: "uint64_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "strict float",
+			mode:   CoercionStrict,
+			row:    []bigquery.Value{float64(0.5)},
+			schema: bigquery.Schema{{Name: "float_value", Type: bigquery.FloatFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetFloatValue(0.5)
+				return &result
+			},
+		},
+		{
+			name:          "strict float precision",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{float64(0.1)},
+			schema:        bigquery.Schema{{Name: "float_value", Type: bigquery.FloatFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "strict float overflow",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{float64(1e39)},
+			schema:        bigquery.Schema{{Name: "float_value", Type: bigquery.FloatFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "strict enum overflow",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(1 << 40)},
+			schema:        bigquery.Schema{{Name: "enum_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "strict Int32Value overflow",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(math.MaxInt32 + 1)},
+			schema:        bigquery.Schema{{Name: "int32_wrapper_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "strict string into int64",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{"42"},
+			schema:        bigquery.Schema{{Name: "int64_value", Type: bigquery.StringFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:   "lenient string into int64",
+			mode:   CoercionLenient,
+			row:    []bigquery.Value{"-42"},
+			schema: bigquery.Schema{{Name: "int64_value", Type: bigquery.StringFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(-42)
+				return &result
+			},
+		},
+		{
+			name:          "lenient invalid string into int64",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{"forty-two"},
+			schema:        bigquery.Schema{{Name: "int64_value", Type: bigquery.StringFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "lenient string overflowing int64",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{"99999999999999999999"},
+			schema:        bigquery.Schema{{Name: "int64_value", Type: bigquery.StringFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "lenient string overflowing int32",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{"2147483648"},
+			schema:        bigquery.Schema{{Name: "int32_value", Type: bigquery.StringFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name: "lenient strings into double and bool",
+			mode: CoercionLenient,
+			row:  []bigquery.Value{"1.5", "true"},
+			schema: bigquery.Schema{
+				{Name: "double_value", Type: bigquery.StringFieldType},
+				{Name: "bool_value", Type: bigquery.StringFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDoubleValue(1.5)
+				result.SetBoolValue(true)
+				return &result
+			},
+		},
+		{
+			name:   "lenient integer into bool",
+			mode:   CoercionLenient,
+			row:    []bigquery.Value{int64(1)},
+			schema: bigquery.Schema{{Name: "bool_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetBoolValue(true)
+				return &result
+			},
+		},
+		{
+			name:          "lenient invalid integer into bool",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{int64(2)},
+			schema:        bigquery.Schema{{Name: "bool_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name: "lenient numbers and bools into strings",
+			mode: CoercionLenient,
+			row:  []bigquery.Value{int64(42), false},
+			schema: bigquery.Schema{
+				{Name: "string_value", Type: bigquery.IntegerFieldType},
+				{Name: "string_wrapper_value", Type: bigquery.BooleanFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetStringValue("42")
+				result.SetStringWrapperValue(wrapperspb.String("false"))
+				return &result
+			},
+		},
+		{
+			name:   "lenient float into string",
+			mode:   CoercionLenient,
+			row:    []bigquery.Value{float64(1.5)},
+			schema: bigquery.Schema{{Name: "string_value", Type: bigquery.FloatFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetStringValue("1.5")
+				return &result
+			},
+		},
+		{
+			name: "lenient integral float into int32",
+			mode: CoercionLenient,
+			row:  []bigquery.Value{float64(3), int64(1 << 53)},
+			schema: bigquery.Schema{
+				{Name: "int32_value", Type: bigquery.FloatFieldType},
+				{Name: "double_wrapper_value", Type: bigquery.IntegerFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt32Value(3)
+				result.SetDoubleWrapperValue(wrapperspb.Double(1 << 53))
+				return &result
+			},
+		},
+		{
+			name:          "lenient fractional float into int32",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{float64(3.5)},
+			schema:        bigquery.Schema{{Name: "int32_value", Type: bigquery.FloatFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "lenient inexact integer into double",
+			mode:          CoercionLenient,
+			row:           []bigquery.Value{int64(1<<53 + 1)},
+			schema:        bigquery.Schema{{Name: "double_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:   "lenient string into UInt32Value",
+			mode:   CoercionLenient,
+			row:    []bigquery.Value{"7"},
+			schema: bigquery.Schema{{Name: "uint32_wrapper_value", Type: bigquery.StringFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint32WrapperValue(wrapperspb.UInt32(7))
+				return &result
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}, CoercionMode: test.mode}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMessageLoader_unsignedWrappersFromInteger(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		mode          CoercionMode
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name: "in range",
+			row:  []bigquery.Value{int64(math.MaxUint32), int64(math.MaxInt64)},
+			schema: bigquery.Schema{
+				{Name: "uint32_wrapper_value", Type: bigquery.IntegerFieldType},
+				{Name: "uint64_wrapper_value", Type: bigquery.IntegerFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint32WrapperValue(wrapperspb.UInt32(math.MaxUint32))
+				result.SetUint64WrapperValue(wrapperspb.UInt64(math.MaxInt64))
+				return &result
+			},
+		},
+		{
+			name:          "UInt32Value overflow",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(math.MaxUint32 + 1)},
+			schema:        bigquery.Schema{{Name: "uint32_wrapper_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "negative UInt32Value",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(-1)},
+			schema:        bigquery.Schema{{Name: "uint32_wrapper_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "negative UInt64Value",
+			mode:          CoercionStrict,
+			row:           []bigquery.Value{int64(-1)},
+			schema:        bigquery.Schema{{Name: "uint64_wrapper_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "wrapped negative UInt64Value",
+			mode:   CoercionTruncate,
+			row:    []bigquery.Value{int64(-1)},
+			schema: bigquery.Schema{{Name: "uint64_wrapper_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint64WrapperValue(wrapperspb.UInt64(math.MaxUint64))
+				return &result
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}, CoercionMode: test.mode}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
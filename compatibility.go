@@ -0,0 +1,297 @@
+package protobq
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IssueKind is the kind of a compatibility Issue.
+type IssueKind int
+
+const (
+	// IssueUnknownColumn indicates a column without a corresponding field, which fails loading.
+	// Columns are not reported if MessageLoader.DiscardUnknown is set, or if the message stores unknown columns
+	// in a field with the option (protobq.v1.field).unknown_columns.
+	IssueUnknownColumn IssueKind = iota + 1
+	// IssueReservedColumn indicates a column matching a reserved field name.
+	// The column is ignored when loading.
+	IssueReservedColumn
+	// IssueModeMismatch indicates a REPEATED column for a singular field, or vice versa.
+	IssueModeMismatch
+	// IssueTypeMismatch indicates a column type that can not be loaded into the field.
+	IssueTypeMismatch
+	// IssueUnsupportedType indicates a field type that is not supported by MessageLoader.
+	IssueUnsupportedType
+	// IssueMissingColumn indicates a field of MessageLoader.FieldMask without a corresponding column.
+	IssueMissingColumn
+)
+
+// String returns a string representation of the issue kind.
+func (k IssueKind) String() string {
+	switch k {
+	case IssueUnknownColumn:
+		return "unknown column"
+	case IssueReservedColumn:
+		return "reserved column"
+	case IssueModeMismatch:
+		return "mode mismatch"
+	case IssueTypeMismatch:
+		return "type mismatch"
+	case IssueUnsupportedType:
+		return "unsupported type"
+	case IssueMissingColumn:
+		return "missing column"
+	default:
+		return fmt.Sprintf("IssueKind(%d)", int(k))
+	}
+}
+
+// Issue describes a BigQuery column that can not be loaded into a message as-is.
+type Issue struct {
+	// Path is the dot-separated path of the column in the BigQuery schema.
+	Path string
+	// Kind of the issue.
+	Kind IssueKind
+	// Message describes the issue.
+	Message string
+}
+
+// String returns a string representation of the issue.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Path, i.Kind, i.Message)
+}
+
+// CheckCompatibility statically checks whether rows with the given bigquery.Schema can be
+// loaded by a MessageLoader with default options into messages of the given descriptor.
+// It returns an empty result if the schema is compatible.
+func CheckCompatibility(bqSchema bigquery.Schema, message protoreflect.MessageDescriptor) []Issue {
+	return new(MessageLoader).CheckCompatibility(bqSchema, message)
+}
+
+// CheckCompatibility statically checks whether rows with the given bigquery.Schema can be
+// loaded by the MessageLoader into messages of the given descriptor.
+// It returns an empty result if the schema is compatible.
+//
+// Columns are resolved to fields with the same load plans as Load, following the FieldResolver, FieldMask
+// and DiscardUnknown options. Column types are checked by loading a value of the type, as returned by the
+// BigQuery client, following the CoercionMode, so that a column is compatible if Load accepts its type,
+// regardless of whether each of its values is valid.
+func (o *MessageLoader) CheckCompatibility(bqSchema bigquery.Schema, message protoreflect.MessageDescriptor) []Issue {
+	c := compatibilityChecker{loader: o}
+	mask, err := o.fieldMask(message)
+	if err != nil {
+		c.report("", IssueMissingColumn, "%v", err)
+		return c.issues
+	}
+	c.checkMessage("", bqSchema, message, mask)
+	return c.issues
+}
+
+type compatibilityChecker struct {
+	loader *MessageLoader
+	issues []Issue
+}
+
+func (c *compatibilityChecker) report(path string, kind IssueKind, format string, args ...any) {
+	c.issues = append(c.issues, Issue{Path: path, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *compatibilityChecker) checkMessage(
+	parentPath string,
+	bqSchema bigquery.Schema,
+	message protoreflect.MessageDescriptor,
+	mask *fieldMask,
+) {
+	plan := c.loader.loadPlan(bqSchema, message, mask)
+	if plan.unknownColumns != nil {
+		if err := checkUnknownColumnsField(plan.unknownColumns); err != nil {
+			c.report(joinPath(parentPath, err.Path), IssueUnsupportedType, "%v", err.Err)
+		}
+	}
+	for i, bqFieldSchema := range bqSchema {
+		path := joinPath(parentPath, bqFieldSchema.Name)
+		column := &plan.columns[i]
+		switch {
+		case column.field != nil:
+			c.checkField(path, bqFieldSchema, column.field, column.mask)
+		case column.discriminator != nil:
+			if bqFieldSchema.Type != bigquery.StringFieldType || bqFieldSchema.Repeated {
+				c.report(path, IssueTypeMismatch, "expected STRING column for oneof discriminator %s", column.discriminator.FullName())
+			}
+		case column.currency:
+			if bqFieldSchema.Type != bigquery.StringFieldType || bqFieldSchema.Repeated {
+				c.report(path, IssueTypeMismatch, "expected STRING column for currency of %s", plan.currencyField(i).FullName())
+			}
+		case column.masked:
+		case column.reserved:
+			c.report(path, IssueReservedColumn, "reserved field in %s", message.FullName())
+		case plan.unknownColumns == nil && !c.loader.DiscardUnknown:
+			c.report(path, IssueUnknownColumn, "no field in %s", message.FullName())
+		}
+	}
+	if mask == nil {
+		return
+	}
+	for _, name := range mask.names() {
+		if plan.unknownColumns != nil && plan.unknownColumns.Name() == name {
+			continue
+		}
+		if !slices.ContainsFunc(plan.columns, func(column columnPlan) bool {
+			return column.field != nil && column.field.Name() == name
+		}) {
+			c.report(joinPath(parentPath, string(name)), IssueMissingColumn, "no column for masked field %s", message.Fields().ByName(name).FullName())
+		}
+	}
+}
+
+func (c *compatibilityChecker) checkField(
+	path string,
+	bqFieldSchema *bigquery.FieldSchema,
+	field protoreflect.FieldDescriptor,
+	mask *fieldMask,
+) {
+	switch {
+	case field.IsList():
+		if !bqFieldSchema.Repeated {
+			c.report(path, IssueModeMismatch, "non-REPEATED column for repeated field %s", field.FullName())
+			return
+		}
+		c.checkValue(path, bqFieldSchema, field, nil)
+	case field.IsMap():
+		if !bqFieldSchema.Repeated {
+			c.report(path, IssueModeMismatch, "non-REPEATED column for map field %s", field.FullName())
+			return
+		}
+		if bqFieldSchema.Type != bigquery.RecordFieldType ||
+			len(bqFieldSchema.Schema) != 2 ||
+			bqFieldSchema.Schema[0].Name != "key" ||
+			bqFieldSchema.Schema[1].Name != "value" {
+			c.report(path, IssueTypeMismatch, "expected REPEATED RECORD<key, value> for map field %s", field.FullName())
+			return
+		}
+		c.checkValue(path+".key", bqFieldSchema.Schema[0], field.MapKey(), nil)
+		c.checkValue(path+".value", bqFieldSchema.Schema[1], field.MapValue(), nil)
+	default:
+		if bqFieldSchema.Repeated {
+			c.report(path, IssueModeMismatch, "REPEATED column for singular field %s", field.FullName())
+			return
+		}
+		c.checkValue(path, bqFieldSchema, field, mask)
+	}
+}
+
+// checkValue checks a column against a singular field, or against the elements of a repeated or map field.
+func (c *compatibilityChecker) checkValue(
+	path string,
+	bqFieldSchema *bigquery.FieldSchema,
+	field protoreflect.FieldDescriptor,
+	mask *fieldMask,
+) {
+	if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+		if !c.loads(bqFieldSchema, func(bqValue bigquery.Value) error {
+			_, err := c.loader.unmarshalScalar(bqValue, bqFieldSchema, field)
+			return err
+		}) {
+			c.report(path, IssueTypeMismatch, "can not load %s into %s field %s", bqFieldSchema.Type, field.Kind(), field.FullName())
+		}
+		return
+	}
+	message := field.Message()
+	switch {
+	case isLoadedAsWellKnownType(message.FullName(), bqFieldSchema.Type):
+		if !c.loads(bqFieldSchema, func(bqValue bigquery.Value) error {
+			_, err := c.loader.unmarshalWellKnownTypeField(bqValue, field)
+			return err
+		}) {
+			c.report(path, IssueTypeMismatch, "can not load %s into %s field %s", bqFieldSchema.Type, message.FullName(), field.FullName())
+		}
+	case isDecimalType(message.FullName()) && bqFieldSchema.Type != bigquery.RecordFieldType:
+		c.report(path, IssueTypeMismatch, "can not load %s into %s field %s", bqFieldSchema.Type, message.FullName(), field.FullName())
+	case bqFieldSchema.Type == bigquery.IntervalFieldType && isIntervalMessage(message):
+	case bqFieldSchema.Type == bigquery.GeographyFieldType && isGeometryField(field):
+	case bqFieldSchema.Type == bigquery.RangeFieldType:
+		if !isRangeMessage(message) {
+			c.report(path, IssueTypeMismatch, "RANGE column for non-range message %s", message.FullName())
+		}
+	case bqFieldSchema.Type == bigquery.RecordFieldType:
+		c.checkMessage(path, bqFieldSchema.Schema, message, mask)
+	case message.FullName().Parent() == "google.protobuf" || message.FullName().Parent() == "google.type":
+		c.report(path, IssueUnsupportedType, "unsupported well-known-type: %s", message.FullName())
+	default:
+		c.report(path, IssueTypeMismatch, "can not load %s into message field %s", bqFieldSchema.Type, field.FullName())
+	}
+}
+
+// loads reports whether the loader accepts a sample value of the column type.
+// Errors about the sample value itself, such as an unknown enum value, do not make the type incompatible.
+func (c *compatibilityChecker) loads(bqFieldSchema *bigquery.FieldSchema, load func(bqValue bigquery.Value) error) bool {
+	for _, bqValue := range sampleValues(bqFieldSchema) {
+		err := load(bqValue)
+		if !errors.Is(err, ErrTypeMismatch) && !errors.Is(err, ErrSchemaMismatch) && !errors.Is(err, ErrUnsupportedType) {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleValues returns values of the column type, as returned by the BigQuery client.
+// Types of which the values have different shapes, such as JSON and GEOGRAPHY, have a sample of each shape.
+func sampleValues(bqFieldSchema *bigquery.FieldSchema) []bigquery.Value {
+	switch bqFieldSchema.Type {
+	case bigquery.StringFieldType:
+		return []bigquery.Value{""}
+	case bigquery.BytesFieldType:
+		return []bigquery.Value{[]byte{}}
+	case bigquery.IntegerFieldType:
+		return []bigquery.Value{int64(0)}
+	case bigquery.FloatFieldType:
+		return []bigquery.Value{float64(0)}
+	case bigquery.BooleanFieldType:
+		return []bigquery.Value{false}
+	case bigquery.TimestampFieldType:
+		return []bigquery.Value{time.Unix(0, 0).UTC()}
+	case bigquery.DateFieldType:
+		return []bigquery.Value{civil.Date{Year: 1970, Month: time.January, Day: 1}}
+	case bigquery.TimeFieldType:
+		return []bigquery.Value{civil.Time{}}
+	case bigquery.DateTimeFieldType:
+		return []bigquery.Value{civil.DateTime{Date: civil.Date{Year: 1970, Month: time.January, Day: 1}}}
+	case bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		return []bigquery.Value{new(big.Rat)}
+	case bigquery.IntervalFieldType:
+		return []bigquery.Value{&bigquery.IntervalValue{}}
+	case bigquery.RangeFieldType:
+		return []bigquery.Value{&bigquery.RangeValue{}}
+	case bigquery.JSONFieldType:
+		return []bigquery.Value{"{}", "[]", "null", `""`}
+	case bigquery.GeographyFieldType:
+		return []bigquery.Value{"POINT(0 0)", "LINESTRING(0 0, 1 1)", "POLYGON((0 0, 1 0, 1 1, 0 0))"}
+	case bigquery.RecordFieldType:
+		record := make([]bigquery.Value, len(bqFieldSchema.Schema))
+		for i, bqNestedSchema := range bqFieldSchema.Schema {
+			if bqNestedSchema.Repeated {
+				record[i] = []bigquery.Value{}
+			} else if samples := sampleValues(bqNestedSchema); len(samples) > 0 {
+				record[i] = samples[0]
+			}
+		}
+		return []bigquery.Value{record}
+	default:
+		return nil
+	}
+}
+
+// joinPath appends the column name to the dot-separated path of its parent column.
+func joinPath(parentPath, name string) string {
+	if parentPath == "" {
+		return name
+	}
+	return parentPath + "." + name
+}
@@ -0,0 +1,515 @@
+package protobq
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	kitchenSink := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	for _, test := range []struct {
+		name     string
+		schema   bigquery.Schema
+		expected []Issue
+	}{
+		{
+			name: "compatible scalars",
+			schema: bigquery.Schema{
+				{Name: "string_value", Type: bigquery.StringFieldType},
+				{Name: "int32_value", Type: bigquery.IntegerFieldType},
+				{Name: "double_value", Type: bigquery.NumericFieldType},
+				{Name: "int64_value", Type: bigquery.TimestampFieldType},
+				{Name: "enum_value", Type: bigquery.StringFieldType},
+			},
+		},
+
+		{
+			name: "unknown column",
+			schema: bigquery.Schema{
+				{Name: "unknown_field", Type: bigquery.StringFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "unknown_field",
+					Kind:    IssueUnknownColumn,
+					Message: "no field in wayplatform.testdata.v1.KitchenSink",
+				},
+			},
+		},
+
+		{
+			name: "type mismatch",
+			schema: bigquery.Schema{
+				{Name: "int32_value", Type: bigquery.BytesFieldType},
+				{Name: "timestamp_value", Type: bigquery.DateFieldType},
+				{Name: "nested_message", Type: bigquery.StringFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "int32_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load BYTES into int32 field wayplatform.testdata.v1.KitchenSink.int32_value",
+				},
+				{
+					Path:    "timestamp_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load DATE into google.protobuf.Timestamp field wayplatform.testdata.v1.KitchenSink.timestamp_value",
+				},
+				{
+					Path:    "nested_message",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load STRING into message field wayplatform.testdata.v1.KitchenSink.nested_message",
+				},
+			},
+		},
+
+		{
+			name: "Any columns",
+			schema: bigquery.Schema{
+				{Name: "any_value", Type: bigquery.JSONFieldType},
+				{
+					Name:     "repeated_any",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "type_url", Type: bigquery.StringFieldType},
+						{Name: "value", Type: bigquery.BytesFieldType},
+					},
+				},
+			},
+		},
+
+		{
+			name: "Any type mismatch",
+			schema: bigquery.Schema{
+				{Name: "any_value", Type: bigquery.BytesFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "any_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load BYTES into google.protobuf.Any field wayplatform.testdata.v1.KitchenSink.any_value",
+				},
+			},
+		},
+
+		{
+			name: "decimal columns",
+			schema: bigquery.Schema{
+				{Name: "decimal_value", Type: bigquery.BigNumericFieldType},
+				{Name: "fraction_value", Type: bigquery.NumericFieldType},
+				{Name: "price", Type: bigquery.NumericFieldType},
+				{Name: "price_currency", Type: bigquery.StringFieldType},
+				{Name: "int64_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "string_wrapper_value", Type: bigquery.BigNumericFieldType},
+				{
+					Name: "money_value",
+					Type: bigquery.RecordFieldType,
+					Schema: bigquery.Schema{
+						{Name: "currency_code", Type: bigquery.StringFieldType},
+						{Name: "units", Type: bigquery.IntegerFieldType},
+						{Name: "nanos", Type: bigquery.IntegerFieldType},
+					},
+				},
+			},
+		},
+
+		{
+			name: "decimal type mismatch",
+			schema: bigquery.Schema{
+				{Name: "decimal_value", Type: bigquery.FloatFieldType},
+				{Name: "price", Type: bigquery.NumericFieldType},
+				{Name: "price_currency", Type: bigquery.IntegerFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "decimal_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load FLOAT into google.type.Decimal field wayplatform.testdata.v1.KitchenSink.decimal_value",
+				},
+				{
+					Path:    "price_currency",
+					Kind:    IssueTypeMismatch,
+					Message: "expected STRING column for currency of wayplatform.testdata.v1.KitchenSink.price",
+				},
+			},
+		},
+
+		{
+			name: "interval columns",
+			schema: bigquery.Schema{
+				{Name: "duration_value", Type: bigquery.IntervalFieldType},
+				{Name: "interval_value", Type: bigquery.IntervalFieldType},
+				{Name: "repeated_interval", Type: bigquery.IntervalFieldType, Repeated: true},
+				{Name: "string_value", Type: bigquery.IntervalFieldType},
+			},
+		},
+
+		{
+			name: "interval type mismatch",
+			schema: bigquery.Schema{
+				{Name: "nested_message", Type: bigquery.IntervalFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "nested_message",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load INTERVAL into message field wayplatform.testdata.v1.KitchenSink.nested_message",
+				},
+			},
+		},
+
+		{
+			name: "epoch columns",
+			schema: bigquery.Schema{
+				{Name: "timestamp_millis", Type: bigquery.IntegerFieldType},
+				{Name: "duration_millis", Type: bigquery.IntegerFieldType},
+				{Name: "date_value", Type: bigquery.IntegerFieldType},
+				{Name: "unix_seconds", Type: bigquery.TimestampFieldType},
+				{Name: "int64_value", Type: bigquery.DateFieldType},
+				{Name: "sfixed64_value", Type: bigquery.TimestampFieldType},
+			},
+		},
+
+		{
+			name: "geography columns",
+			schema: bigquery.Schema{
+				{Name: "latlng_value", Type: bigquery.GeographyFieldType},
+				{Name: "line_string", Type: bigquery.GeographyFieldType},
+				{Name: "polygon", Type: bigquery.GeographyFieldType},
+				{Name: "multi_point", Type: bigquery.GeographyFieldType},
+				{Name: "route", Type: bigquery.GeographyFieldType},
+				{Name: "repeated_polygon", Type: bigquery.GeographyFieldType, Repeated: true},
+			},
+		},
+
+		{
+			name: "geography type mismatch",
+			schema: bigquery.Schema{
+				{Name: "interval_value", Type: bigquery.GeographyFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "interval_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load GEOGRAPHY into message field wayplatform.testdata.v1.KitchenSink.interval_value",
+				},
+			},
+		},
+
+		{
+			name: "mode mismatch",
+			schema: bigquery.Schema{
+				{Name: "repeated_string", Type: bigquery.StringFieldType},
+				{Name: "string_value", Type: bigquery.StringFieldType, Repeated: true},
+				{Name: "map_string_string", Type: bigquery.RecordFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "repeated_string",
+					Kind:    IssueModeMismatch,
+					Message: "non-REPEATED column for repeated field wayplatform.testdata.v1.KitchenSink.repeated_string",
+				},
+				{
+					Path:    "string_value",
+					Kind:    IssueModeMismatch,
+					Message: "REPEATED column for singular field wayplatform.testdata.v1.KitchenSink.string_value",
+				},
+				{
+					Path:    "map_string_string",
+					Kind:    IssueModeMismatch,
+					Message: "non-REPEATED column for map field wayplatform.testdata.v1.KitchenSink.map_string_string",
+				},
+			},
+		},
+
+		{
+			name: "nested paths",
+			schema: bigquery.Schema{
+				{
+					Name:     "map_string_nested",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "key", Type: bigquery.StringFieldType},
+						{
+							Name: "value",
+							Type: bigquery.RecordFieldType,
+							Schema: bigquery.Schema{
+								{Name: "text", Type: bigquery.StringFieldType},
+								{Name: "number", Type: bigquery.FloatFieldType},
+								{Name: "extra", Type: bigquery.StringFieldType},
+							},
+						},
+					},
+				},
+			},
+			expected: []Issue{
+				{
+					Path:    "map_string_nested.value.number",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load FLOAT into int32 field wayplatform.testdata.v1.NestedMessage.number",
+				},
+				{
+					Path:    "map_string_nested.value.extra",
+					Kind:    IssueUnknownColumn,
+					Message: "no field in wayplatform.testdata.v1.NestedMessage",
+				},
+			},
+		},
+
+		{
+			name: "oneof discriminator",
+			schema: bigquery.Schema{
+				{
+					Name: "nested_message",
+					Type: bigquery.RecordFieldType,
+					Schema: bigquery.Schema{
+						{Name: "optional_value", Type: bigquery.IntegerFieldType},
+						{Name: "string_option", Type: bigquery.StringFieldType},
+					},
+				},
+				{
+					Name:     "map_string_nested",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "key", Type: bigquery.StringFieldType},
+						{
+							Name: "value",
+							Type: bigquery.RecordFieldType,
+							Schema: bigquery.Schema{
+								{Name: "optional_value", Type: bigquery.StringFieldType},
+							},
+						},
+					},
+				},
+			},
+			expected: []Issue{
+				{
+					Path:    "nested_message.optional_value",
+					Kind:    IssueTypeMismatch,
+					Message: "expected STRING column for oneof discriminator wayplatform.testdata.v1.NestedMessage.optional_value",
+				},
+			},
+		},
+
+		{
+			name: "range columns",
+			schema: bigquery.Schema{
+				{Name: "date_range", Type: bigquery.RangeFieldType},
+				{Name: "nested_message", Type: bigquery.RangeFieldType},
+			},
+			expected: []Issue{
+				{
+					Path:    "nested_message",
+					Kind:    IssueTypeMismatch,
+					Message: "RANGE column for non-range message wayplatform.testdata.v1.NestedMessage",
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			actual := CheckCompatibility(test.schema, kitchenSink)
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("unexpected issues (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckCompatibility_reservedColumn(t *testing.T) {
+	descriptor := (&descriptorpb.FileOptions{}).ProtoReflect().Descriptor()
+	actual := CheckCompatibility(bigquery.Schema{
+		{Name: "java_package", Type: bigquery.StringFieldType},
+		{Name: "php_generic_services", Type: bigquery.BooleanFieldType},
+	}, descriptor)
+	expected := []Issue{
+		{
+			Path:    "php_generic_services",
+			Kind:    IssueReservedColumn,
+			Message: "reserved field in google.protobuf.FileOptions",
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected issues (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestCheckCompatibility_unsupportedWellKnownType(t *testing.T) {
+	descriptor := (&apipb.Api{}).ProtoReflect().Descriptor()
+	actual := CheckCompatibility(bigquery.Schema{
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "source_context", Type: bigquery.JSONFieldType},
+	}, descriptor)
+	expected := []Issue{
+		{
+			Path:    "source_context",
+			Kind:    IssueUnsupportedType,
+			Message: "unsupported well-known-type: google.protobuf.SourceContext",
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected issues (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestCheckCompatibility_unknownColumns(t *testing.T) {
+	descriptor := (&testdatav1.UnknownColumnsStruct{}).ProtoReflect().Descriptor()
+	actual := CheckCompatibility(bigquery.Schema{
+		{Name: "id", Type: bigquery.StringFieldType},
+		{Name: "added_column", Type: bigquery.IntegerFieldType},
+	}, descriptor)
+	if len(actual) != 0 {
+		t.Errorf("expected no issues, got %v", actual)
+	}
+}
+
+func TestCheckCompatibility_schemaFor(t *testing.T) {
+	descriptor := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	schema, err := SchemaFor(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues := CheckCompatibility(schema, descriptor); len(issues) != 0 {
+		t.Errorf("expected generated schema to be compatible, got %v", issues)
+	}
+}
+
+func TestMessageLoader_CheckCompatibility(t *testing.T) {
+	renamedFields := (&testdatav1.RenamedFields{}).ProtoReflect().Descriptor()
+	kitchenSink := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	for _, test := range []struct {
+		name       string
+		loader     MessageLoader
+		schema     bigquery.Schema
+		descriptor protoreflect.MessageDescriptor
+		expected   []Issue
+	}{
+		{
+			name: "field resolver",
+			loader: MessageLoader{
+				FieldResolver: ChainFieldResolvers(ResolveFieldByColumnOption, ResolveFieldByJSONName),
+			},
+			schema: bigquery.Schema{
+				{Name: "uid", Type: bigquery.IntegerFieldType},
+				{Name: "author", Type: bigquery.StringFieldType},
+				{Name: "displayName", Type: bigquery.BytesFieldType},
+			},
+			descriptor: renamedFields,
+			expected: []Issue{
+				{
+					Path:    "displayName",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load BYTES into string field wayplatform.testdata.v1.RenamedFields.display_name",
+				},
+			},
+		},
+		{
+			name: "default field resolver",
+			schema: bigquery.Schema{
+				{Name: "uid", Type: bigquery.IntegerFieldType},
+				{Name: "display_name", Type: bigquery.StringFieldType},
+			},
+			descriptor: renamedFields,
+		},
+		{
+			name:   "field resolver by name",
+			loader: MessageLoader{FieldResolver: ResolveFieldByName},
+			schema: bigquery.Schema{
+				{Name: "uid", Type: bigquery.IntegerFieldType},
+			},
+			descriptor: renamedFields,
+			expected: []Issue{
+				{
+					Path:    "uid",
+					Kind:    IssueUnknownColumn,
+					Message: "no field in wayplatform.testdata.v1.RenamedFields",
+				},
+			},
+		},
+		{
+			name:   "discard unknown",
+			loader: MessageLoader{DiscardUnknown: true},
+			schema: bigquery.Schema{
+				{Name: "uid", Type: bigquery.IntegerFieldType},
+			},
+			descriptor: renamedFields,
+		},
+		{
+			name:   "lenient coercion",
+			loader: MessageLoader{CoercionMode: CoercionLenient},
+			schema: bigquery.Schema{
+				{Name: "int32_value", Type: bigquery.StringFieldType},
+				{Name: "bool_value", Type: bigquery.IntegerFieldType},
+				{Name: "int64_wrapper_value", Type: bigquery.StringFieldType},
+			},
+			descriptor: kitchenSink,
+		},
+		{
+			name:   "default coercion",
+			schema: bigquery.Schema{{Name: "int32_value", Type: bigquery.StringFieldType}},
+			expected: []Issue{
+				{
+					Path:    "int32_value",
+					Kind:    IssueTypeMismatch,
+					Message: "can not load STRING into int32 field wayplatform.testdata.v1.KitchenSink.int32_value",
+				},
+			},
+			descriptor: kitchenSink,
+		},
+		{
+			name: "field mask",
+			loader: MessageLoader{
+				FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"string_value", "nested_message.text"}},
+			},
+			schema: bigquery.Schema{
+				{Name: "int32_value", Type: bigquery.BytesFieldType},
+				{Name: "unknown_field", Type: bigquery.StringFieldType},
+				{
+					Name:   "nested_message",
+					Type:   bigquery.RecordFieldType,
+					Schema: bigquery.Schema{{Name: "number", Type: bigquery.StringFieldType}},
+				},
+			},
+			descriptor: kitchenSink,
+			expected: []Issue{
+				{
+					Path:    "nested_message.text",
+					Kind:    IssueMissingColumn,
+					Message: "no column for masked field wayplatform.testdata.v1.NestedMessage.text",
+				},
+				{
+					Path:    "string_value",
+					Kind:    IssueMissingColumn,
+					Message: "no column for masked field wayplatform.testdata.v1.KitchenSink.string_value",
+				},
+			},
+		},
+		{
+			name:       "invalid field mask",
+			loader:     MessageLoader{FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"no_such_field"}}},
+			descriptor: kitchenSink,
+			expected: []Issue{
+				{
+					Kind:    IssueMissingColumn,
+					Message: `invalid field mask path "no_such_field": no field no_such_field in wayplatform.testdata.v1.KitchenSink`,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			actual := test.loader.CheckCompatibility(test.schema, test.descriptor)
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("unexpected issues (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
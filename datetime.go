@@ -0,0 +1,99 @@
+package protobq
+
+import (
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// dateTimeZone is the time zone of google.type.DateTime fields, as set by MessageLoader.TimeZone.
+type dateTimeZone struct {
+	id       string
+	location *time.Location
+	// isOffset is set for UTC offsets, which are loaded as utc_offset instead of time_zone.
+	isOffset bool
+}
+
+// setTimeOffset sets the time offset of the result to the time zone, or to its UTC offset at the given time.
+func (z *dateTimeZone) setTimeOffset(result *datetime.DateTime, t time.Time) {
+	if z.isOffset {
+		setUTCOffset(result, t)
+		return
+	}
+	result.TimeOffset = &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: z.location.String()}}
+}
+
+// dateTimeZone returns the parsed TimeZone, or nil if not set.
+func (o *MessageLoader) dateTimeZone() (*dateTimeZone, error) {
+	if o.TimeZone == "" {
+		return nil, nil
+	}
+	if o.timeZone == nil || o.timeZone.id != o.TimeZone {
+		location, err := parseTimeZone(o.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		o.timeZone = &dateTimeZone{
+			id:       o.TimeZone,
+			location: location,
+			isOffset: strings.HasPrefix(o.TimeZone, "+") || strings.HasPrefix(o.TimeZone, "-"),
+		}
+	}
+	return o.timeZone, nil
+}
+
+// unmarshalDateTime loads a DATETIME value, as a civil.DateTime, or a TIMESTAMP value, as a time.Time,
+// into a google.type.DateTime in the time zone of the MessageLoader.
+func (o *MessageLoader) unmarshalDateTime(bqValue bigquery.Value) (*datetime.DateTime, error) {
+	zone, err := o.dateTimeZone()
+	if err != nil {
+		return nil, newLoadError(ErrInvalidValue, bqValue, "invalid time zone %q for %s: %w", o.TimeZone, kwtDateTime, err)
+	}
+	switch v := bqValue.(type) {
+	case civil.DateTime:
+		result := newDateTime(v)
+		if zone != nil {
+			zone.setTimeOffset(result, v.In(zone.location))
+		}
+		return result, nil
+	case time.Time:
+		if zone != nil {
+			v = v.In(zone.location)
+			result := newDateTime(civil.DateTimeOf(v))
+			zone.setTimeOffset(result, v)
+			return result, nil
+		}
+		result := newDateTime(civil.DateTimeOf(v))
+		if v.Location() == time.UTC {
+			result.TimeOffset = &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "UTC"}}
+		} else {
+			// Locations other than UTC may not have an IANA time zone ID, but always have a UTC offset.
+			setUTCOffset(result, v)
+		}
+		return result, nil
+	default:
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", kwtDateTime, bqValue)
+	}
+}
+
+func newDateTime(v civil.DateTime) *datetime.DateTime {
+	return &datetime.DateTime{
+		Year:    int32(v.Date.Year),
+		Month:   int32(v.Date.Month),
+		Day:     int32(v.Date.Day),
+		Hours:   int32(v.Time.Hour),
+		Minutes: int32(v.Time.Minute),
+		Seconds: int32(v.Time.Second),
+		Nanos:   int32(v.Time.Nanosecond),
+	}
+}
+
+// setUTCOffset sets the time offset of the result to the UTC offset of the time.
+func setUTCOffset(result *datetime.DateTime, t time.Time) {
+	_, offset := t.Zone()
+	result.TimeOffset = &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(time.Duration(offset) * time.Second)}
+}
@@ -0,0 +1,123 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestMessageLoader_dateTime(t *testing.T) {
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	civilDateTime := civil.DateTime{
+		Date: civil.Date{Year: 2022, Month: time.July, Day: 1},
+		Time: civil.Time{Hour: 12, Minute: 30, Nanosecond: 500},
+	}
+	newDateTime := func(hours int32) *datetime.DateTime {
+		return &datetime.DateTime{Year: 2022, Month: 7, Day: 1, Hours: hours, Minutes: 30, Nanos: 500}
+	}
+	withTimeZone := func(result *datetime.DateTime, id string) *datetime.DateTime {
+		result.TimeOffset = &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: id}}
+		return result
+	}
+	withUTCOffset := func(result *datetime.DateTime, offset time.Duration) *datetime.DateTime {
+		result.TimeOffset = &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(offset)}
+		return result
+	}
+	for _, test := range []struct {
+		name          string
+		timeZone      string
+		value         bigquery.Value
+		bqType        bigquery.FieldType
+		expected      *datetime.DateTime
+		expectedError error
+	}{
+		{
+			name:     "DATETIME",
+			value:    civilDateTime,
+			bqType:   bigquery.DateTimeFieldType,
+			expected: newDateTime(12),
+		},
+		{
+			name:     "DATETIME in time zone",
+			timeZone: "America/Los_Angeles",
+			value:    civilDateTime,
+			bqType:   bigquery.DateTimeFieldType,
+			expected: withTimeZone(newDateTime(12), "America/Los_Angeles"),
+		},
+		{
+			name:     "DATETIME at UTC offset",
+			timeZone: "+05:30",
+			value:    civilDateTime,
+			bqType:   bigquery.DateTimeFieldType,
+			expected: withUTCOffset(newDateTime(12), 5*time.Hour+30*time.Minute),
+		},
+		{
+			name:     "TIMESTAMP",
+			value:    civilDateTime.In(time.UTC),
+			bqType:   bigquery.TimestampFieldType,
+			expected: withTimeZone(newDateTime(12), "UTC"),
+		},
+		{
+			name:     "TIMESTAMP in location",
+			value:    civilDateTime.In(losAngeles),
+			bqType:   bigquery.TimestampFieldType,
+			expected: withUTCOffset(newDateTime(12), -7*time.Hour),
+		},
+		{
+			name:     "TIMESTAMP converted to time zone",
+			timeZone: "America/Los_Angeles",
+			value:    civilDateTime.In(time.UTC),
+			bqType:   bigquery.TimestampFieldType,
+			expected: withTimeZone(newDateTime(5), "America/Los_Angeles"),
+		},
+		{
+			name:     "TIMESTAMP converted to UTC offset",
+			timeZone: "-03:00",
+			value:    civilDateTime.In(time.UTC),
+			bqType:   bigquery.TimestampFieldType,
+			expected: withUTCOffset(newDateTime(9), -3*time.Hour),
+		},
+		{
+			name:          "invalid time zone",
+			timeZone:      "PST",
+			value:         civilDateTime,
+			bqType:        bigquery.DateTimeFieldType,
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unsupported value",
+			value:         "2022-07-01 12:30:00",
+			bqType:        bigquery.DateTimeFieldType,
+			expectedError: ErrTypeMismatch,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}, TimeZone: test.timeZone}
+			err := loader.Load([]bigquery.Value{test.value}, bigquery.Schema{{Name: "datetime_value", Type: test.bqType}})
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := loader.Message.(*testdatav1.KitchenSink).GetDatetimeValue()
+			if diff := cmp.Diff(test.expected, actual, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected DateTime (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package protobq
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/way-platform/protobq-go/protobqpb"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EpochUnit is the unit of integers holding timestamps and dates, as time since the Unix epoch,
+// and durations.
+//
+// It applies to google.protobuf.Timestamp, google.protobuf.Duration and google.type.Date fields
+// loaded from INTEGER columns, and to int64 fields loaded from TIMESTAMP and DATE columns.
+// MessageLoader has a unit for each of timestamps, durations and dates, so that changing the unit
+// of one does not rescale the others.
+// Values that can not be represented in the unit without truncation fail with ErrPrecisionLoss.
+type EpochUnit int
+
+const (
+	// EpochUnitDefault uses the default unit of the field: microseconds for timestamps,
+	// seconds for durations and days for dates. Timestamps in INT32 values default to seconds.
+	EpochUnitDefault EpochUnit = iota
+	// EpochUnitSeconds counts seconds.
+	EpochUnitSeconds
+	// EpochUnitMilliseconds counts milliseconds.
+	EpochUnitMilliseconds
+	// EpochUnitMicroseconds counts microseconds.
+	EpochUnitMicroseconds
+	// EpochUnitNanoseconds counts nanoseconds.
+	EpochUnitNanoseconds
+	// EpochUnitDays counts days of 24 hours.
+	EpochUnitDays
+)
+
+// String returns a string representation of the epoch unit.
+func (u EpochUnit) String() string {
+	switch u {
+	case EpochUnitDefault:
+		return "default"
+	case EpochUnitSeconds:
+		return "seconds"
+	case EpochUnitMilliseconds:
+		return "milliseconds"
+	case EpochUnitMicroseconds:
+		return "microseconds"
+	case EpochUnitNanoseconds:
+		return "nanoseconds"
+	case EpochUnitDays:
+		return "days"
+	default:
+		return fmt.Sprintf("EpochUnit(%d)", int(u))
+	}
+}
+
+// nanos returns the number of nanoseconds in the unit.
+func (u EpochUnit) nanos() int64 {
+	switch u {
+	case EpochUnitSeconds:
+		return int64(time.Second)
+	case EpochUnitMilliseconds:
+		return int64(time.Millisecond)
+	case EpochUnitMicroseconds:
+		return int64(time.Microsecond)
+	case EpochUnitDays:
+		return secondsPerDay * int64(time.Second)
+	default:
+		return 1
+	}
+}
+
+// epochUnit returns the epoch unit of the field, as set by the field option (protobq.v1.field).epoch_unit
+// or by the unit of the MessageLoader for the kind of value, or the given default unit.
+func epochUnit(field protoreflect.FieldDescriptor, loaderUnit, defaultUnit EpochUnit) EpochUnit {
+	if fieldOptions := fieldOptions(field); fieldOptions != nil &&
+		fieldOptions.GetEpochUnit() != protobqpb.EpochUnit_EPOCH_UNIT_UNSPECIFIED {
+		// The values of the option match the values of EpochUnit.
+		return EpochUnit(fieldOptions.GetEpochUnit())
+	}
+	if loaderUnit != EpochUnitDefault {
+		return loaderUnit
+	}
+	return defaultUnit
+}
+
+// epochDuration converts a number of units to seconds and nanoseconds.
+func epochDuration(bqValue bigquery.Value, n int64, unit EpochUnit) (seconds, nanos int64, err error) {
+	if unit == EpochUnitDays {
+		if n > math.MaxInt64/secondsPerDay || n < math.MinInt64/secondsPerDay {
+			return 0, 0, newLoadError(ErrOutOfRange, bqValue, "%d %s overflows seconds", n, unit)
+		}
+		return n * secondsPerDay, 0, nil
+	}
+	perSecond := int64(time.Second) / unit.nanos()
+	return n / perSecond, n % perSecond * unit.nanos(), nil
+}
+
+// newTimestamp returns the Timestamp of the time, or ErrOutOfRange if it is outside the range of Timestamp.
+func newTimestamp(bqValue bigquery.Value, t time.Time) (*timestamppb.Timestamp, error) {
+	result := timestamppb.New(t)
+	if err := result.CheckValid(); err != nil {
+		return nil, newLoadError(ErrOutOfRange, bqValue, "invalid %s: %w", wktTimestamp, err)
+	}
+	return result, nil
+}
+
+// epochTimestamp converts a number of units since the Unix epoch to a Timestamp.
+func epochTimestamp(bqValue bigquery.Value, n int64, unit EpochUnit) (*timestamppb.Timestamp, error) {
+	seconds, nanos, err := epochDuration(bqValue, n, unit)
+	if err != nil {
+		return nil, err
+	}
+	return newTimestamp(bqValue, time.Unix(seconds, nanos))
+}
+
+// newDurationFromEpoch converts a number of units to a Duration.
+func newDurationFromEpoch(bqValue bigquery.Value, n int64, unit EpochUnit) (*durationpb.Duration, error) {
+	seconds, nanos, err := epochDuration(bqValue, n, unit)
+	if err != nil {
+		return nil, err
+	}
+	result := &durationpb.Duration{Seconds: seconds, Nanos: int32(nanos)}
+	if err := result.CheckValid(); err != nil {
+		return nil, newLoadError(ErrOutOfRange, bqValue, "invalid %s: %w", wktDuration, err)
+	}
+	return result, nil
+}
+
+// epochDate converts a number of units since the Unix epoch to a Date,
+// or ErrPrecisionLoss if it is not at midnight UTC.
+func epochDate(bqValue bigquery.Value, n int64, unit EpochUnit) (*date.Date, error) {
+	timestamp, err := epochTimestamp(bqValue, n, unit)
+	if err != nil {
+		return nil, err
+	}
+	t := timestamp.AsTime()
+	if t.Truncate(24*time.Hour) != t {
+		return nil, newLoadError(ErrPrecisionLoss, bqValue, "%d %s since epoch is not at midnight UTC", n, unit)
+	}
+	return &date.Date{Year: int32(t.Year()), Month: int32(t.Month()), Day: int32(t.Day())}, nil
+}
+
+// timeEpoch converts a time to a number of units since the Unix epoch.
+func timeEpoch(bqValue bigquery.Value, t time.Time, unit EpochUnit) (int64, error) {
+	seconds, nanos := t.Unix(), int64(t.Nanosecond())
+	if unit == EpochUnitDays {
+		if nanos != 0 || seconds%secondsPerDay != 0 {
+			return 0, newLoadError(ErrPrecisionLoss, bqValue, "%v is not at midnight UTC", t)
+		}
+		return seconds / secondsPerDay, nil
+	}
+	if nanos%unit.nanos() != 0 {
+		return 0, newLoadError(ErrPrecisionLoss, bqValue, "%v can not be represented in %s", t, unit)
+	}
+	perSecond := int64(time.Second) / unit.nanos()
+	if seconds > (math.MaxInt64-perSecond)/perSecond || seconds < math.MinInt64/perSecond {
+		return 0, newLoadError(ErrOutOfRange, bqValue, "%v overflows int64 %s", t, unit)
+	}
+	return seconds*perSecond + nanos/unit.nanos(), nil
+}
+
+// dateEpoch converts a date to a number of units since the Unix epoch.
+func dateEpoch(bqValue bigquery.Value, d civil.Date, unit EpochUnit) (int64, error) {
+	days := int64(d.DaysSince(civil.Date{Year: 1970, Month: time.January, Day: 1}))
+	if unit == EpochUnitDays {
+		return days, nil
+	}
+	return timeEpoch(bqValue, time.Unix(days*secondsPerDay, 0), unit)
+}
+
+// unmarshalEpochInt64 loads a TIMESTAMP or DATE value into an int64 field, as a number of units since the Unix epoch.
+func (o *MessageLoader) unmarshalEpochInt64(bqValue bigquery.Value, field protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	var result int64
+	var err error
+	switch v := bqValue.(type) {
+	case time.Time:
+		result, err = timeEpoch(bqValue, v, epochUnit(field, o.EpochUnit, EpochUnitMicroseconds))
+	case civil.Date:
+		result, err = dateEpoch(bqValue, v, epochUnit(field, o.DateEpochUnit, EpochUnitDays))
+	default:
+		err = newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value %#v for kind %v", bqValue, field.Kind())
+	}
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfInt64(result), nil
+}
@@ -0,0 +1,282 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMessageLoader_epochUnit(t *testing.T) {
+	const days2020 = 18262 // days from 1970-01-01 to 2020-01-01
+	for _, test := range []struct {
+		name          string
+		unit          EpochUnit
+		durationUnit  EpochUnit
+		dateUnit      EpochUnit
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "Timestamp in default microseconds",
+			row:    []bigquery.Value{int64(1500000)},
+			schema: bigquery.Schema{{Name: "timestamp_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetTimestampValue(&timestamppb.Timestamp{Seconds: 1, Nanos: 500000000})
+				return &result
+			},
+		},
+		{
+			name:   "Timestamp in loader milliseconds",
+			unit:   EpochUnitMilliseconds,
+			row:    []bigquery.Value{int64(-1500)},
+			schema: bigquery.Schema{{Name: "timestamp_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetTimestampValue(&timestamppb.Timestamp{Seconds: -2, Nanos: 500000000})
+				return &result
+			},
+		},
+		{
+			name:   "Timestamp in field milliseconds",
+			unit:   EpochUnitSeconds,
+			row:    []bigquery.Value{int64(1500)},
+			schema: bigquery.Schema{{Name: "timestamp_millis", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetTimestampMillis(&timestamppb.Timestamp{Seconds: 1, Nanos: 500000000})
+				return &result
+			},
+		},
+		{
+			name:   "repeated Timestamp in field nanoseconds",
+			row:    []bigquery.Value{[]bigquery.Value{int64(1), int64(1000000000)}},
+			schema: bigquery.Schema{{Name: "repeated_timestamp_nanos", Type: bigquery.IntegerFieldType, Repeated: true}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetRepeatedTimestampNanos([]*timestamppb.Timestamp{{Nanos: 1}, {Seconds: 1}})
+				return &result
+			},
+		},
+		{
+			name:          "Timestamp after year 9999",
+			unit:          EpochUnitSeconds,
+			row:           []bigquery.Value{int64(253402300800)},
+			schema:        bigquery.Schema{{Name: "timestamp_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "Timestamp before year 1",
+			row:           []bigquery.Value{time.Date(0, time.December, 31, 0, 0, 0, 0, time.UTC)},
+			schema:        bigquery.Schema{{Name: "timestamp_value", Type: bigquery.TimestampFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "Timestamp in days overflow",
+			unit:          EpochUnitDays,
+			row:           []bigquery.Value{int64(1 << 62)},
+			schema:        bigquery.Schema{{Name: "timestamp_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "Duration in default seconds",
+			row:    []bigquery.Value{int64(90)},
+			schema: bigquery.Schema{{Name: "duration_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationValue(&durationpb.Duration{Seconds: 90})
+				return &result
+			},
+		},
+		{
+			name:   "Duration in field milliseconds",
+			row:    []bigquery.Value{int64(-1500)},
+			schema: bigquery.Schema{{Name: "duration_millis", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationMillis(&durationpb.Duration{Seconds: -1, Nanos: -500000000})
+				return &result
+			},
+		},
+		{
+			name:         "Duration in loader days",
+			durationUnit: EpochUnitDays,
+			row:          []bigquery.Value{float64(1.5)},
+			schema:       bigquery.Schema{{Name: "duration_value", Type: bigquery.FloatFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationValue(&durationpb.Duration{Seconds: 36 * 3600})
+				return &result
+			},
+		},
+		{
+			name:          "Duration out of range",
+			durationUnit:  EpochUnitDays,
+			row:           []bigquery.Value{int64(4000000)},
+			schema:        bigquery.Schema{{Name: "duration_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "Date in default days",
+			row:    []bigquery.Value{int64(days2020)},
+			schema: bigquery.Schema{{Name: "date_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDateValue(&date.Date{Year: 2020, Month: 1, Day: 1})
+				return &result
+			},
+		},
+		{
+			name:     "Date in loader milliseconds",
+			dateUnit: EpochUnitMilliseconds,
+			row:      []bigquery.Value{int64(days2020 * 86400000)},
+			schema:   bigquery.Schema{{Name: "date_value", Type: bigquery.IntegerFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDateValue(&date.Date{Year: 2020, Month: 1, Day: 1})
+				return &result
+			},
+		},
+		{
+			name:          "Date not at midnight",
+			dateUnit:      EpochUnitMilliseconds,
+			row:           []bigquery.Value{int64(days2020*86400000 + 1)},
+			schema:        bigquery.Schema{{Name: "date_value", Type: bigquery.IntegerFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:   "int64 from TIMESTAMP in default microseconds",
+			row:    []bigquery.Value{time.Unix(1, 500000000)},
+			schema: bigquery.Schema{{Name: "int64_value", Type: bigquery.TimestampFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(1500000)
+				return &result
+			},
+		},
+		{
+			name:   "int64 from TIMESTAMP in field seconds",
+			row:    []bigquery.Value{time.Unix(-90, 0)},
+			schema: bigquery.Schema{{Name: "unix_seconds", Type: bigquery.TimestampFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUnixSeconds(-90)
+				return &result
+			},
+		},
+		{
+			name:          "int64 from TIMESTAMP with fractional seconds",
+			row:           []bigquery.Value{time.Unix(1, 500000000)},
+			schema:        bigquery.Schema{{Name: "unix_seconds", Type: bigquery.TimestampFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "int64 from TIMESTAMP overflowing nanoseconds",
+			unit:          EpochUnitNanoseconds,
+			row:           []bigquery.Value{time.Date(3000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			schema:        bigquery.Schema{{Name: "int64_value", Type: bigquery.TimestampFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "sint64 from DATE in default days",
+			row:    []bigquery.Value{civil.Date{Year: 2020, Month: time.January, Day: 1}},
+			schema: bigquery.Schema{{Name: "sint64_value", Type: bigquery.DateFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetSint64Value(days2020)
+				return &result
+			},
+		},
+		{
+			name:     "int64 from DATE in loader milliseconds",
+			dateUnit: EpochUnitMilliseconds,
+			row:      []bigquery.Value{civil.Date{Year: 2020, Month: time.January, Day: 1}},
+			schema:   bigquery.Schema{{Name: "int64_value", Type: bigquery.DateFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(days2020 * 86400000)
+				return &result
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{
+				Message:           &testdatav1.KitchenSink{},
+				EpochUnit:         test.unit,
+				DurationEpochUnit: test.durationUnit,
+				DateEpochUnit:     test.dateUnit,
+			}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMessageLoader_epochUnitPerKind(t *testing.T) {
+	const days2020 = 18262 // days from 1970-01-01 to 2020-01-01
+	schema := bigquery.Schema{
+		{Name: "timestamp_value", Type: bigquery.IntegerFieldType},
+		{Name: "duration_value", Type: bigquery.IntegerFieldType},
+		{Name: "date_value", Type: bigquery.IntegerFieldType},
+		{Name: "int64_value", Type: bigquery.DateFieldType},
+	}
+	row := []bigquery.Value{int64(1500), int64(90), int64(days2020), civil.Date{Year: 2020, Month: time.January, Day: 1}}
+	t.Run("timestamp unit", func(t *testing.T) {
+		loader := MessageLoader{Message: &testdatav1.KitchenSink{}, EpochUnit: EpochUnitMilliseconds}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.KitchenSink
+		expected.SetTimestampValue(&timestamppb.Timestamp{Seconds: 1, Nanos: 500000000})
+		expected.SetDurationValue(&durationpb.Duration{Seconds: 90})
+		expected.SetDateValue(&date.Date{Year: 2020, Month: 1, Day: 1})
+		expected.SetInt64Value(days2020)
+		if diff := cmp.Diff(&expected, loader.Message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("unit of each kind", func(t *testing.T) {
+		loader := MessageLoader{
+			Message:           &testdatav1.KitchenSink{},
+			EpochUnit:         EpochUnitMilliseconds,
+			DurationEpochUnit: EpochUnitMilliseconds,
+			DateEpochUnit:     EpochUnitSeconds,
+		}
+		row := []bigquery.Value{int64(1500), int64(90), int64(days2020 * 86400), row[3]}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.KitchenSink
+		expected.SetTimestampValue(&timestamppb.Timestamp{Seconds: 1, Nanos: 500000000})
+		expected.SetDurationValue(&durationpb.Duration{Nanos: 90000000})
+		expected.SetDateValue(&date.Date{Year: 2020, Month: 1, Day: 1})
+		expected.SetInt64Value(days2020 * 86400)
+		if diff := cmp.Diff(&expected, loader.Message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package protobq
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Sentinel errors identifying the kind of a LoadError, for use with errors.Is.
+var (
+	// ErrUnknownField is returned for a column without a corresponding message field.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrMissingColumn is returned for a field of MessageLoader.FieldMask without a corresponding column.
+	ErrMissingColumn = errors.New("missing column")
+	// ErrSchemaMismatch is returned when a row does not have the shape of its schema.
+	ErrSchemaMismatch = errors.New("schema mismatch")
+	// ErrTypeMismatch is returned for a value of a Go type that can not be loaded into the field.
+	ErrTypeMismatch = errors.New("type mismatch")
+	// ErrInvalidValue is returned for a value that fails to parse or validate.
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrOutOfRange is returned for a value that does not fit into the field.
+	ErrOutOfRange = errors.New("out of range")
+	// ErrPrecisionLoss is returned for a value that can not be loaded into the field without losing precision.
+	ErrPrecisionLoss = errors.New("precision loss")
+	// ErrNullValue is returned in NullStrict and NullStrictPresence modes for a NULL value of a field that rejects them.
+	ErrNullValue = errors.New("null value")
+	// ErrUnsupportedType is returned for a field of a type that is not supported.
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrValidation is returned for a loaded message that fails MessageLoader.Validator.
+	ErrValidation = errors.New("validation failed")
+	// ErrOneofConflict is returned in OneofStrict mode for a row with multiple non-null members of a oneof.
+	ErrOneofConflict = errors.New("oneof conflict")
+)
+
+// LoadError is the error returned by MessageLoader when a BigQuery value can not be loaded,
+// and by MessageSaver when a field can not be saved as a BigQuery value.
+//
+// Use errors.Is with the sentinel errors (e.g. ErrTypeMismatch) to check the kind of error,
+// and errors.As to access the details.
+type LoadError struct {
+	// Path of the column, e.g. map_string_nested[key="a"].value.items[3].
+	Path string
+	// FieldType is the BigQuery type of the column.
+	FieldType bigquery.FieldType
+	// Field is the full name of the protobuf field.
+	Field protoreflect.FullName
+	// Value is the offending BigQuery value.
+	Value bigquery.Value
+	// Kind is the sentinel error identifying the kind of error.
+	Kind error
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *LoadError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the kind and the underlying error.
+func (e *LoadError) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+func newLoadError(kind error, value bigquery.Value, format string, args ...any) *LoadError {
+	return &LoadError{
+		Value: value,
+		Kind:  kind,
+		Err:   fmt.Errorf(format, args...),
+	}
+}
+
+// wrapLoadError prepends a path segment to the error, converting it to a *LoadError if needed.
+// The field type and field are only set if not already set for a nested column.
+func wrapLoadError(
+	err error,
+	segment string,
+	bqFieldSchema *bigquery.FieldSchema,
+	field protoreflect.FieldDescriptor,
+) error {
+	loadErr, ok := err.(*LoadError)
+	if !ok {
+		loadErr = &LoadError{Kind: ErrInvalidValue, Err: err}
+	}
+	switch {
+	case loadErr.Path == "":
+		loadErr.Path = segment
+	case strings.HasPrefix(loadErr.Path, "["):
+		loadErr.Path = segment + loadErr.Path
+	default:
+		loadErr.Path = segment + "." + loadErr.Path
+	}
+	if loadErr.FieldType == "" && bqFieldSchema != nil {
+		loadErr.FieldType = bqFieldSchema.Type
+	}
+	if loadErr.Field == "" && field != nil {
+		loadErr.Field = field.FullName()
+	}
+	return loadErr
+}
+
+// indexSegment returns the path segment of a list element.
+func indexSegment(i int) string {
+	return fmt.Sprintf("[%d]", i)
+}
+
+// mapEntrySegment returns the path segment of a map entry, identified by key when possible.
+func mapEntrySegment(i int, bqMapEntry bigquery.Value) string {
+	var key bigquery.Value
+	switch bqMapEntry := bqMapEntry.(type) {
+	case map[string]bigquery.Value:
+		key = bqMapEntry["key"]
+	case []bigquery.Value:
+		if len(bqMapEntry) > 0 {
+			key = bqMapEntry[0]
+		}
+	}
+	switch key := key.(type) {
+	case nil:
+		return indexSegment(i)
+	case string:
+		return fmt.Sprintf("[key=%q]", key)
+	default:
+		return fmt.Sprintf("[key=%v]", key)
+	}
+}
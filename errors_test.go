@@ -0,0 +1,192 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestLoadError(t *testing.T) {
+	nestedSchema := bigquery.Schema{
+		{Name: "text", Type: bigquery.StringFieldType},
+		{Name: "number", Type: bigquery.IntegerFieldType},
+	}
+	for _, test := range []struct {
+		name              string
+		row               []bigquery.Value
+		schema            bigquery.Schema
+		expectedKind      error
+		expectedPath      string
+		expectedFieldType bigquery.FieldType
+		expectedField     protoreflect.FullName
+		expectedValue     bigquery.Value
+	}{
+		{
+			name:              "unknown column",
+			row:               []bigquery.Value{"foo"},
+			schema:            bigquery.Schema{{Name: "unknown", Type: bigquery.StringFieldType}},
+			expectedKind:      ErrUnknownField,
+			expectedPath:      "unknown",
+			expectedFieldType: bigquery.StringFieldType,
+			expectedValue:     "foo",
+		},
+		{
+			name:              "scalar type mismatch",
+			row:               []bigquery.Value{"foo"},
+			schema:            bigquery.Schema{{Name: "int32_value", Type: bigquery.IntegerFieldType}},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      "int32_value",
+			expectedFieldType: bigquery.IntegerFieldType,
+			expectedField:     "wayplatform.testdata.v1.KitchenSink.int32_value",
+			expectedValue:     "foo",
+		},
+		{
+			name:              "NUMERIC out of range",
+			row:               []bigquery.Value{"99999999999"},
+			schema:            bigquery.Schema{{Name: "int32_value", Type: bigquery.NumericFieldType}},
+			expectedKind:      ErrOutOfRange,
+			expectedPath:      "int32_value",
+			expectedFieldType: bigquery.NumericFieldType,
+			expectedField:     "wayplatform.testdata.v1.KitchenSink.int32_value",
+			expectedValue:     "99999999999",
+		},
+		{
+			name:              "invalid timestamp",
+			row:               []bigquery.Value{"yesterday"},
+			schema:            bigquery.Schema{{Name: "timestamp_value", Type: bigquery.StringFieldType}},
+			expectedKind:      ErrInvalidValue,
+			expectedPath:      "timestamp_value",
+			expectedFieldType: bigquery.StringFieldType,
+			expectedField:     "wayplatform.testdata.v1.KitchenSink.timestamp_value",
+			expectedValue:     "yesterday",
+		},
+		{
+			name: "list element",
+			row:  []bigquery.Value{[]bigquery.Value{"a", int64(1)}},
+			schema: bigquery.Schema{
+				{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+			},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      "repeated_string[1]",
+			expectedFieldType: bigquery.StringFieldType,
+			expectedField:     "wayplatform.testdata.v1.KitchenSink.repeated_string",
+			expectedValue:     int64(1),
+		},
+		{
+			name: "nested list message",
+			row: []bigquery.Value{
+				[]bigquery.Value{
+					[]bigquery.Value{"a", int64(1)},
+					[]bigquery.Value{"b", "two"},
+				},
+			},
+			schema: bigquery.Schema{
+				{Name: "repeated_nested", Type: bigquery.RecordFieldType, Repeated: true, Schema: nestedSchema},
+			},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      "repeated_nested[1].number",
+			expectedFieldType: bigquery.IntegerFieldType,
+			expectedField:     "wayplatform.testdata.v1.NestedMessage.number",
+			expectedValue:     "two",
+		},
+		{
+			name: "map value message",
+			row: []bigquery.Value{
+				[]bigquery.Value{
+					map[string]bigquery.Value{
+						"key":   "a",
+						"value": []bigquery.Value{"text", "one"},
+					},
+				},
+			},
+			schema: bigquery.Schema{
+				{
+					Name:     "map_string_nested",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "key", Type: bigquery.StringFieldType},
+						{Name: "value", Type: bigquery.RecordFieldType, Schema: nestedSchema},
+					},
+				},
+			},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      `map_string_nested[key="a"].value.number`,
+			expectedFieldType: bigquery.IntegerFieldType,
+			expectedField:     "wayplatform.testdata.v1.NestedMessage.number",
+			expectedValue:     "one",
+		},
+		{
+			name: "map value scalar",
+			row: []bigquery.Value{
+				[]bigquery.Value{
+					[]bigquery.Value{int64(7), int64(8)},
+				},
+			},
+			schema: bigquery.Schema{
+				{
+					Name:     "map_int32_string",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "key", Type: bigquery.IntegerFieldType},
+						{Name: "value", Type: bigquery.StringFieldType},
+					},
+				},
+			},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      "map_int32_string[key=7].value",
+			expectedFieldType: bigquery.StringFieldType,
+			expectedField:     "wayplatform.testdata.v1.KitchenSink.MapInt32StringEntry.value",
+			expectedValue:     int64(8),
+		},
+		{
+			name: "range end",
+			row: []bigquery.Value{
+				&bigquery.RangeValue{Start: "2024-01-01", End: int64(1)},
+			},
+			schema: bigquery.Schema{
+				{
+					Name:             "date_range",
+					Type:             bigquery.RangeFieldType,
+					RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateFieldType},
+				},
+			},
+			expectedKind:      ErrTypeMismatch,
+			expectedPath:      "date_range.end",
+			expectedFieldType: bigquery.RangeFieldType,
+			expectedField:     "wayplatform.testdata.v1.DateRange.end",
+			expectedValue:     int64(1),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+			err := loader.Load(test.row, test.schema)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !errors.Is(err, test.expectedKind) {
+				t.Errorf("expected error of kind %v, got %v", test.expectedKind, err)
+			}
+			var loadErr *LoadError
+			if !errors.As(err, &loadErr) {
+				t.Fatalf("expected *LoadError, got %T", err)
+			}
+			if loadErr.Path != test.expectedPath {
+				t.Errorf("expected path %q, got %q", test.expectedPath, loadErr.Path)
+			}
+			if loadErr.FieldType != test.expectedFieldType {
+				t.Errorf("expected field type %s, got %s", test.expectedFieldType, loadErr.FieldType)
+			}
+			if loadErr.Field != test.expectedField {
+				t.Errorf("expected field %s, got %s", test.expectedField, loadErr.Field)
+			}
+			if loadErr.Value != test.expectedValue {
+				t.Errorf("expected value %#v, got %#v", test.expectedValue, loadErr.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package protobq
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldMask is a compiled google.protobuf.FieldMask, as set by MessageLoader.FieldMask.
+// A nil fieldMask masks all fields.
+type fieldMask struct {
+	// fields holds the mask of each masked field of the message, nil for fields masked as a whole.
+	fields map[protoreflect.Name]*fieldMask
+}
+
+// fieldMaskCache holds the FieldMask compiled for a message descriptor.
+type fieldMaskCache struct {
+	paths   []string
+	message protoreflect.MessageDescriptor
+	mask    *fieldMask
+}
+
+// newFieldMask compiles the paths of a google.protobuf.FieldMask for the message descriptor.
+// Paths consist of proto field names, and only the last field of a path may be a repeated or map field.
+func newFieldMask(message protoreflect.MessageDescriptor, paths []string) (*fieldMask, error) {
+	root := &fieldMask{fields: make(map[protoreflect.Name]*fieldMask)}
+	for _, path := range paths {
+		mask, descriptor := root, message
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			field := descriptor.Fields().ByName(protoreflect.Name(segment))
+			if field == nil {
+				return nil, newLoadError(ErrInvalidValue, nil, "invalid field mask path %q: no field %s in %s", path, segment, descriptor.FullName())
+			}
+			child, ok := mask.fields[field.Name()]
+			if ok && child == nil {
+				// A parent path masks the field as a whole.
+				break
+			}
+			if i == len(segments)-1 {
+				mask.fields[field.Name()] = nil
+				break
+			}
+			if field.IsList() || field.IsMap() || field.Message() == nil {
+				return nil, newLoadError(ErrInvalidValue, nil, "invalid field mask path %q: %s is not a singular message field", path, segment)
+			}
+			if !ok {
+				child = &fieldMask{fields: make(map[protoreflect.Name]*fieldMask)}
+				mask.fields[field.Name()] = child
+			}
+			mask, descriptor = child, field.Message()
+		}
+	}
+	return root, nil
+}
+
+// field returns the mask of the field, and false if the field is not masked.
+func (m *fieldMask) field(field protoreflect.FieldDescriptor) (*fieldMask, bool) {
+	if m == nil {
+		return nil, true
+	}
+	child, ok := m.fields[field.Name()]
+	return child, ok
+}
+
+// names returns the names of the masked fields, in sorted order.
+func (m *fieldMask) names() []protoreflect.Name {
+	names := make([]protoreflect.Name, 0, len(m.fields))
+	for name := range m.fields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// fieldMask returns the FieldMask compiled for the message descriptor, or nil if not set.
+func (o *MessageLoader) fieldMask(message protoreflect.MessageDescriptor) (*fieldMask, error) {
+	paths := o.FieldMask.GetPaths()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	if o.fieldMaskCache == nil || o.fieldMaskCache.message != message || !slices.Equal(o.fieldMaskCache.paths, paths) {
+		mask, err := newFieldMask(message, paths)
+		if err != nil {
+			return nil, err
+		}
+		o.fieldMaskCache = &fieldMaskCache{paths: slices.Clone(paths), message: message, mask: mask}
+	}
+	return o.fieldMaskCache.mask, nil
+}
@@ -0,0 +1,169 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestMessageLoader_fieldMask(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "int64_value", Type: bigquery.IntegerFieldType},
+		{
+			Name: "nested_message",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "number", Type: bigquery.IntegerFieldType},
+				{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+			},
+		},
+	}
+	row := []bigquery.Value{"foo", int64(42), []bigquery.Value{"bar", int64(7), []bigquery.Value{"a", "b"}}}
+	for _, test := range []struct {
+		name          string
+		paths         []string
+		expected      func() proto.Message
+		expectedError error
+		expectedPath  string
+	}{
+		{
+			name:  "no paths",
+			paths: []string{},
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetText("bar")
+				nested.SetNumber(7)
+				nested.SetTags([]string{"a", "b"})
+				var result testdatav1.KitchenSink
+				result.SetStringValue("foo")
+				result.SetInt64Value(42)
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:  "top-level field",
+			paths: []string{"int64_value"},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(42)
+				return &result
+			},
+		},
+		{
+			name:  "nested fields",
+			paths: []string{"string_value", "nested_message.number", "nested_message.tags"},
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetNumber(7)
+				nested.SetTags([]string{"a", "b"})
+				var result testdatav1.KitchenSink
+				result.SetStringValue("foo")
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:  "nested message as a whole",
+			paths: []string{"nested_message.text", "nested_message"},
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetText("bar")
+				nested.SetNumber(7)
+				nested.SetTags([]string{"a", "b"})
+				var result testdatav1.KitchenSink
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:          "missing column",
+			paths:         []string{"string_value", "bool_value"},
+			expectedError: ErrMissingColumn,
+			expectedPath:  "bool_value",
+		},
+		{
+			name:          "missing nested column",
+			paths:         []string{"nested_message.flag"},
+			expectedError: ErrMissingColumn,
+			expectedPath:  "nested_message.flag",
+		},
+		{
+			name:          "unknown field",
+			paths:         []string{"no_such_field"},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "path through repeated field",
+			paths:         []string{"repeated_nested.text"},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "path through scalar field",
+			paths:         []string{"string_value.length"},
+			expectedError: ErrInvalidValue,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{
+				Message:   &testdatav1.KitchenSink{},
+				FieldMask: &fieldmaskpb.FieldMask{Paths: test.paths},
+			}
+			err := loader.Load(row, schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				if loadErr.Path != test.expectedPath {
+					t.Errorf("expected path %q, got %q", test.expectedPath, loadErr.Path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("missing column of NULL record", func(t *testing.T) {
+		loader := MessageLoader{
+			Message:   &testdatav1.KitchenSink{},
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"nested_message.flag"}},
+		}
+		err := loader.Load([]bigquery.Value{"foo", int64(42), nil}, schema)
+		if !errors.Is(err, ErrMissingColumn) {
+			t.Fatalf("expected %v, got %v", ErrMissingColumn, err)
+		}
+	})
+
+	t.Run("skipped columns", func(t *testing.T) {
+		loader := MessageLoader{
+			Message:   &testdatav1.KitchenSink{},
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"string_value"}},
+		}
+		skippedSchema := append(bigquery.Schema{{Name: "unknown_column", Type: bigquery.StringFieldType}}, schema...)
+		skippedRow := []bigquery.Value{"unknown", "foo", "not an integer", "not a record"}
+		if err := loader.Load(skippedRow, skippedSchema); err != nil {
+			t.Fatal(err)
+		}
+		if got := loader.Message.(*testdatav1.KitchenSink).GetStringValue(); got != "foo" {
+			t.Errorf("expected string_value %q, got %q", "foo", got)
+		}
+		loader.FieldMask.Paths = []string{"int64_value"}
+		if err := loader.Load(skippedRow, skippedSchema); !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected %v after changing the paths, got %v", ErrTypeMismatch, err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package protobq
+
+import (
+	"strings"
+
+	"github.com/way-platform/protobq-go/protobqpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldResolver resolves the field of a message to load a BigQuery column into.
+// It returns nil if the message has no field for the column.
+type FieldResolver func(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor
+
+// ResolveFieldByColumn resolves fields by their explicit column name, as set by the field option
+// (protobq.v1.field).column, and then by their proto name. This is the default, matching the columns
+// written by MessageSaver and generated by SchemaFor.
+func ResolveFieldByColumn(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+	if field := ResolveFieldByColumnOption(message, column); field != nil {
+		return field
+	}
+	return ResolveFieldByName(message, column)
+}
+
+// ResolveFieldByName resolves fields by their proto name, ignoring the field option (protobq.v1.field).column.
+func ResolveFieldByName(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+	return message.Fields().ByName(protoreflect.Name(column))
+}
+
+// ResolveFieldByJSONName resolves fields by their JSON name, e.g. lowerCamelCase or a custom json_name.
+func ResolveFieldByJSONName(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+	return message.Fields().ByJSONName(column)
+}
+
+// ResolveFieldCaseInsensitive resolves fields by their proto name or JSON name, ignoring case.
+func ResolveFieldCaseInsensitive(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+	fields := message.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if strings.EqualFold(string(field.Name()), column) || strings.EqualFold(field.JSONName(), column) {
+			return field
+		}
+	}
+	return nil
+}
+
+// ResolveFieldByColumnOption resolves fields by their explicit column name,
+// as set by the field option (protobq.v1.field).column.
+func ResolveFieldByColumnOption(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+	fields := message.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if name, ok := columnOption(field); ok && name == column {
+			return field
+		}
+	}
+	return nil
+}
+
+// ChainFieldResolvers returns a FieldResolver trying each of the given resolvers in order.
+func ChainFieldResolvers(resolvers ...FieldResolver) FieldResolver {
+	return func(message protoreflect.MessageDescriptor, column string) protoreflect.FieldDescriptor {
+		for _, resolver := range resolvers {
+			if field := resolver(message, column); field != nil {
+				return field
+			}
+		}
+		return nil
+	}
+}
+
+// columnOption returns the column name set by the field option (protobq.v1.field).column.
+func columnOption(field protoreflect.FieldDescriptor) (string, bool) {
+	fieldOptions := fieldOptions(field)
+	if fieldOptions == nil || !fieldOptions.HasColumn() {
+		return "", false
+	}
+	return fieldOptions.GetColumn(), true
+}
+
+// columnName returns the column of the field, as set by the field option (protobq.v1.field).column,
+// or the proto name of the field.
+func columnName(field protoreflect.FieldDescriptor) string {
+	if name, ok := columnOption(field); ok {
+		return name
+	}
+	return string(field.Name())
+}
+
+// fieldOptions returns the field option (protobq.v1.field), or nil if not set.
+func fieldOptions(field protoreflect.FieldDescriptor) *protobqpb.FieldOptions {
+	options := field.Options()
+	if options == nil || !proto.HasExtension(options, protobqpb.E_Field) {
+		return nil
+	}
+	fieldOptions, _ := proto.GetExtension(options, protobqpb.E_Field).(*protobqpb.FieldOptions)
+	return fieldOptions
+}
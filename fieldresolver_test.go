@@ -0,0 +1,82 @@
+package protobq
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestFieldResolver(t *testing.T) {
+	descriptor := (&testdatav1.RenamedFields{}).ProtoReflect().Descriptor()
+	for _, test := range []struct {
+		name     string
+		resolver FieldResolver
+		column   string
+		expected protoreflect.Name
+	}{
+		{name: "by name", resolver: ResolveFieldByName, column: "display_name", expected: "display_name"},
+		{name: "by name no match", resolver: ResolveFieldByName, column: "displayName"},
+		{name: "by JSON name", resolver: ResolveFieldByJSONName, column: "displayName", expected: "display_name"},
+		{name: "by custom JSON name", resolver: ResolveFieldByJSONName, column: "author", expected: "created_by"},
+		{name: "case insensitive name", resolver: ResolveFieldCaseInsensitive, column: "DISPLAY_NAME", expected: "display_name"},
+		{name: "case insensitive JSON name", resolver: ResolveFieldCaseInsensitive, column: "Author", expected: "created_by"},
+		{name: "by column option", resolver: ResolveFieldByColumnOption, column: "uid", expected: "user_id"},
+		{name: "by column option no match", resolver: ResolveFieldByColumnOption, column: "user_id"},
+		{name: "by column", resolver: ResolveFieldByColumn, column: "uid", expected: "user_id"},
+		{name: "by column falls back to name", resolver: ResolveFieldByColumn, column: "display_name", expected: "display_name"},
+		{
+			name:     "chain",
+			resolver: ChainFieldResolvers(ResolveFieldByColumnOption, ResolveFieldByName),
+			column:   "user_id",
+			expected: "user_id",
+		},
+		{
+			name:     "chain no match",
+			resolver: ChainFieldResolvers(ResolveFieldByColumnOption, ResolveFieldByName),
+			column:   "unknown",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var actual protoreflect.Name
+			if field := test.resolver(descriptor, test.column); field != nil {
+				actual = field.Name()
+			}
+			if actual != test.expected {
+				t.Errorf("expected field %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMessageLoader_fieldResolver(t *testing.T) {
+	var message testdatav1.RenamedFields
+	loader := MessageLoader{
+		FieldResolver: ChainFieldResolvers(
+			ResolveFieldByColumnOption,
+			ResolveFieldByJSONName,
+			ResolveFieldCaseInsensitive,
+		),
+		Message: &message,
+	}
+	if err := loader.Load(
+		[]bigquery.Value{"Ada", "Charles", int64(42)},
+		bigquery.Schema{
+			{Name: "displayName", Type: bigquery.StringFieldType},
+			{Name: "AUTHOR", Type: bigquery.StringFieldType},
+			{Name: "uid", Type: bigquery.IntegerFieldType},
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+	var expected testdatav1.RenamedFields
+	expected.SetDisplayName("Ada")
+	expected.SetCreatedBy("Charles")
+	expected.SetUserId(42)
+	if diff := cmp.Diff(&expected, &message, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+	}
+}
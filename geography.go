@@ -0,0 +1,447 @@
+package protobq
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/way-platform/protobq-go/protobqpb"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// geometryType is the type of a GEOGRAPHY value, as named in GeoJSON.
+type geometryType string
+
+const (
+	geometryPoint      geometryType = "Point"
+	geometryLineString geometryType = "LineString"
+	geometryPolygon    geometryType = "Polygon"
+	geometryMultiPoint geometryType = "MultiPoint"
+)
+
+// geometry is a GEOGRAPHY value parsed from WKT or GeoJSON.
+type geometry struct {
+	typ geometryType
+	// points of a point, line string or multi point.
+	points []*latlng.LatLng
+	// rings of a polygon, with the exterior ring first.
+	rings [][]*latlng.LatLng
+}
+
+// String returns the WKT name of the geometry type.
+func (g *geometry) String() string {
+	return strings.ToUpper(string(g.typ))
+}
+
+// parseGeography parses a GEOGRAPHY value in WKT, e.g. "POINT(-122.4194 37.7749)",
+// or in GeoJSON, e.g. `{"type": "Point", "coordinates": [-122.4194, 37.7749]}`.
+// Only points, line strings, polygons and multi points of two coordinates are supported.
+func parseGeography(s string) (*geometry, error) {
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		return parseGeoJSON(s)
+	}
+	return parseWKT(s)
+}
+
+// parseGeoJSON parses a GeoJSON geometry object.
+func parseGeoJSON(s string) (*geometry, error) {
+	var object struct {
+		Type        geometryType    `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	}
+	if err := json.Unmarshal([]byte(s), &object); err != nil {
+		return nil, err
+	}
+	if object.Coordinates == nil {
+		return nil, fmt.Errorf("missing coordinates of GeoJSON %s", object.Type)
+	}
+	result := &geometry{typ: object.Type}
+	switch object.Type {
+	case geometryPoint:
+		var position []float64
+		if err := json.Unmarshal(object.Coordinates, &position); err != nil {
+			return nil, err
+		}
+		point, err := geoJSONPosition(position)
+		if err != nil {
+			return nil, err
+		}
+		result.points = []*latlng.LatLng{point}
+	case geometryLineString, geometryMultiPoint:
+		var positions [][]float64
+		if err := json.Unmarshal(object.Coordinates, &positions); err != nil {
+			return nil, err
+		}
+		points, err := geoJSONPositions(positions)
+		if err != nil {
+			return nil, err
+		}
+		result.points = points
+	case geometryPolygon:
+		var rings [][][]float64
+		if err := json.Unmarshal(object.Coordinates, &rings); err != nil {
+			return nil, err
+		}
+		for _, positions := range rings {
+			ring, err := geoJSONPositions(positions)
+			if err != nil {
+				return nil, err
+			}
+			result.rings = append(result.rings, ring)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported GeoJSON type %q", object.Type)
+	}
+	return result, nil
+}
+
+func geoJSONPositions(positions [][]float64) ([]*latlng.LatLng, error) {
+	points := make([]*latlng.LatLng, 0, len(positions))
+	for _, position := range positions {
+		point, err := geoJSONPosition(position)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+// geoJSONPosition converts a GeoJSON position of longitude and latitude to a LatLng.
+func geoJSONPosition(position []float64) (*latlng.LatLng, error) {
+	if len(position) != 2 {
+		return nil, fmt.Errorf("expected position of longitude and latitude, got %v", position)
+	}
+	return newGeoPoint(position[0], position[1])
+}
+
+// newGeoPoint returns the LatLng of a longitude and latitude, in degrees.
+func newGeoPoint(longitude, latitude float64) (*latlng.LatLng, error) {
+	if longitude < -180 || longitude > 180 || latitude < -90 || latitude > 90 {
+		return nil, fmt.Errorf("coordinates (%v %v) out of range", longitude, latitude)
+	}
+	return &latlng.LatLng{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// parseWKT parses a geometry in well-known text.
+func parseWKT(s string) (*geometry, error) {
+	p := wktParser{s: s}
+	result, err := p.parseGeometry()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos != len(p.s) {
+		return nil, fmt.Errorf("unexpected %q after WKT %s", p.s[p.pos:], result)
+	}
+	return result, nil
+}
+
+// wktParser is a recursive descent parser of well-known text.
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) parseGeometry() (*geometry, error) {
+	result := &geometry{}
+	keyword := p.keyword()
+	switch strings.ToUpper(keyword) {
+	case "POINT":
+		result.typ = geometryPoint
+	case "LINESTRING":
+		result.typ = geometryLineString
+	case "POLYGON":
+		result.typ = geometryPolygon
+	case "MULTIPOINT":
+		result.typ = geometryMultiPoint
+	case "":
+		return nil, errors.New("missing WKT geometry type")
+	default:
+		return nil, fmt.Errorf("unsupported WKT geometry type %q", keyword)
+	}
+	switch modifier := p.keyword(); {
+	case modifier == "":
+	case !strings.EqualFold(modifier, "EMPTY"):
+		return nil, fmt.Errorf("unsupported WKT %s %s", result, modifier)
+	case result.typ == geometryPoint:
+		return nil, errors.New("unsupported empty WKT POINT")
+	default:
+		return result, nil
+	}
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var err error
+	switch result.typ {
+	case geometryPoint:
+		var point *latlng.LatLng
+		if point, err = p.parsePoint(); err == nil {
+			result.points = []*latlng.LatLng{point}
+		}
+	case geometryLineString:
+		result.points, err = p.parsePoints(false)
+	case geometryMultiPoint:
+		result.points, err = p.parsePoints(true)
+	case geometryPolygon:
+		for err == nil {
+			var ring []*latlng.LatLng
+			if err = p.expect('('); err != nil {
+				break
+			}
+			if ring, err = p.parsePoints(false); err != nil {
+				break
+			}
+			result.rings = append(result.rings, ring)
+			if err = p.expect(')'); err != nil || !p.accept(',') {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// parsePoints parses a comma-separated list of points, each in parentheses if allowed, as in MULTIPOINT((1 2), (3 4)).
+func (p *wktParser) parsePoints(allowParentheses bool) ([]*latlng.LatLng, error) {
+	var points []*latlng.LatLng
+	for {
+		parenthesized := allowParentheses && p.accept('(')
+		point, err := p.parsePoint()
+		if err != nil {
+			return nil, err
+		}
+		if parenthesized {
+			if err := p.expect(')'); err != nil {
+				return nil, err
+			}
+		}
+		points = append(points, point)
+		if !p.accept(',') {
+			return points, nil
+		}
+	}
+}
+
+// parsePoint parses the longitude and latitude of a point.
+func (p *wktParser) parsePoint() (*latlng.LatLng, error) {
+	longitude, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	latitude, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	return newGeoPoint(longitude, latitude)
+}
+
+func (p *wktParser) parseNumber() (float64, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("+-.0123456789eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, fmt.Errorf("expected number at offset %d", start)
+	}
+	return strconv.ParseFloat(p.s[start:p.pos], 64)
+}
+
+// keyword returns the next run of letters, or an empty string if there is none.
+func (p *wktParser) keyword() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && ('A' <= p.s[p.pos] && p.s[p.pos] <= 'Z' || 'a' <= p.s[p.pos] && p.s[p.pos] <= 'z') {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// accept consumes the next character if it is c.
+func (p *wktParser) accept(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) expect(c byte) error {
+	if !p.accept(c) {
+		return fmt.Errorf("expected %q at offset %d", c, p.pos)
+	}
+	return nil
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// geometryShape describes how messages of a field hold GEOGRAPHY values.
+type geometryShape struct {
+	// types of the geometries held by the messages.
+	types []geometryType
+	// points is the repeated google.type.LatLng field of line strings and multi points.
+	points protoreflect.FieldDescriptor
+	// rings is the repeated field of polygon rings, each a message with a repeated google.type.LatLng field.
+	rings protoreflect.FieldDescriptor
+}
+
+// geometryFieldShape returns the geometry shape of a message field loaded from a GEOGRAPHY column.
+//
+// Without the field option (protobq.v1.field).geometry, the message must have a single field:
+// a repeated google.type.LatLng field for line strings and multi points, or a repeated field of rings
+// for polygons. With the option, the message must have a single such field, among other fields.
+func geometryFieldShape(field protoreflect.FieldDescriptor) (geometryShape, bool) {
+	message := field.Message()
+	if message == nil || isWellKnownType(string(message.FullName())) {
+		return geometryShape{}, false
+	}
+	option := protobqpb.GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+	if fieldOptions := fieldOptions(field); fieldOptions != nil {
+		option = fieldOptions.GetGeometry()
+	}
+	if option == protobqpb.GeometryType_GEOMETRY_TYPE_UNSPECIFIED && message.Fields().Len() != 1 {
+		return geometryShape{}, false
+	}
+	points, rings := geometryPointsField(message), geometryRingsField(message)
+	switch {
+	case option == protobqpb.GeometryType_GEOMETRY_TYPE_UNSPECIFIED && points != nil:
+		return geometryShape{types: []geometryType{geometryLineString, geometryMultiPoint}, points: points}, true
+	case option == protobqpb.GeometryType_GEOMETRY_TYPE_UNSPECIFIED && rings != nil:
+		return geometryShape{types: []geometryType{geometryPolygon}, rings: rings}, true
+	case option == protobqpb.GeometryType_GEOMETRY_TYPE_LINE_STRING && points != nil:
+		return geometryShape{types: []geometryType{geometryLineString}, points: points}, true
+	case option == protobqpb.GeometryType_GEOMETRY_TYPE_MULTI_POINT && points != nil:
+		return geometryShape{types: []geometryType{geometryMultiPoint}, points: points}, true
+	case option == protobqpb.GeometryType_GEOMETRY_TYPE_POLYGON && rings != nil:
+		return geometryShape{types: []geometryType{geometryPolygon}, rings: rings}, true
+	default:
+		return geometryShape{}, false
+	}
+}
+
+// isGeometryField reports whether GEOGRAPHY values are loaded into messages of the field.
+func isGeometryField(field protoreflect.FieldDescriptor) bool {
+	_, ok := geometryFieldShape(field)
+	return ok
+}
+
+// geometryPointsField returns the single repeated google.type.LatLng field of the message, or nil.
+func geometryPointsField(message protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	return singleListField(message, func(element protoreflect.MessageDescriptor) bool {
+		return element.FullName() == wktLatLng
+	})
+}
+
+// geometryRingsField returns the single repeated field of the message of which the elements
+// have a single repeated google.type.LatLng field, or nil.
+func geometryRingsField(message protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	return singleListField(message, func(element protoreflect.MessageDescriptor) bool {
+		return element.FullName() != message.FullName() && geometryPointsField(element) != nil
+	})
+}
+
+func singleListField(
+	message protoreflect.MessageDescriptor,
+	match func(element protoreflect.MessageDescriptor) bool,
+) protoreflect.FieldDescriptor {
+	var result protoreflect.FieldDescriptor
+	fields := message.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if !field.IsList() || field.Message() == nil || !match(field.Message()) {
+			continue
+		}
+		if result != nil {
+			return nil
+		}
+		result = field
+	}
+	return result
+}
+
+// parseGeographyValue parses a GEOGRAPHY value, as returned by the BigQuery client.
+func parseGeographyValue(bqValue bigquery.Value, name protoreflect.FullName) (*geometry, error) {
+	s, ok := bqValue.(string)
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", name, bqValue)
+	}
+	result, err := parseGeography(s)
+	if err != nil {
+		return nil, newLoadError(ErrInvalidValue, bqValue, "invalid GEOGRAPHY value for %s: %#v: %w", name, bqValue, err)
+	}
+	return result, nil
+}
+
+func (o *MessageLoader) unmarshalLatLng(bqValue bigquery.Value) (*latlng.LatLng, error) {
+	result, err := parseGeographyValue(bqValue, wktLatLng)
+	if err != nil {
+		return nil, err
+	}
+	if result.typ != geometryPoint {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "can not load GEOGRAPHY %s into %s", result, wktLatLng)
+	}
+	return result.points[0], nil
+}
+
+// unmarshalGeometry loads a GEOGRAPHY value into a geometry message of the given shape.
+func (o *MessageLoader) unmarshalGeometry(bqValue bigquery.Value, shape geometryShape, message protoreflect.Message) error {
+	result, err := parseGeographyValue(bqValue, message.Descriptor().FullName())
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(shape.types, result.typ) {
+		return newLoadError(
+			ErrTypeMismatch, bqValue, "can not load GEOGRAPHY %s into %s", result, message.Descriptor().FullName(),
+		)
+	}
+	if shape.points != nil {
+		appendGeoPoints(message, shape.points, result.points)
+		return nil
+	}
+	rings := message.Mutable(shape.rings).List()
+	for _, points := range result.rings {
+		ring := rings.NewElement()
+		appendGeoPoints(ring.Message(), geometryPointsField(shape.rings.Message()), points)
+		rings.Append(ring)
+	}
+	return nil
+}
+
+func (o *MessageLoader) unmarshalGeometryListField(
+	bqListValue []bigquery.Value,
+	shape geometryShape,
+	field protoreflect.FieldDescriptor,
+	message protoreflect.Message,
+) error {
+	list := message.Mutable(field).List()
+	for i, bqListElementValue := range bqListValue {
+		element := list.NewElement()
+		if err := o.unmarshalGeometry(bqListElementValue, shape, element.Message()); err != nil {
+			return wrapLoadError(err, indexSegment(i), nil, nil)
+		}
+		list.Append(element)
+	}
+	return nil
+}
+
+func appendGeoPoints(message protoreflect.Message, field protoreflect.FieldDescriptor, points []*latlng.LatLng) {
+	list := message.Mutable(field).List()
+	for _, point := range points {
+		list.Append(protoreflect.ValueOfMessage(point.ProtoReflect()))
+	}
+}
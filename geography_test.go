@@ -0,0 +1,243 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMessageLoader_geography(t *testing.T) {
+	points := func() []*latlng.LatLng {
+		return []*latlng.LatLng{
+			{Latitude: 37.8331628, Longitude: -122.48369},
+			{Latitude: 37.8347766, Longitude: -122.48348},
+		}
+	}
+	newRing := func(points ...*latlng.LatLng) *testdatav1.LinearRing {
+		var result testdatav1.LinearRing
+		result.SetPoints(points)
+		return &result
+	}
+	newPolygon := func(rings ...*testdatav1.LinearRing) *testdatav1.Polygon {
+		var result testdatav1.Polygon
+		result.SetRings(rings)
+		return &result
+	}
+	for _, test := range []struct {
+		name          string
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "LatLng from WKT",
+			row:    []bigquery.Value{"point ( -122.4194  37.7749 )"},
+			schema: bigquery.Schema{{Name: "latlng_value", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetLatlngValue(&latlng.LatLng{Latitude: 37.7749, Longitude: -122.4194})
+				return &result
+			},
+		},
+		{
+			name:   "LatLng from GeoJSON",
+			row:    []bigquery.Value{`{"type": "Point", "coordinates": [2.3522, 48.8566]}`},
+			schema: bigquery.Schema{{Name: "latlng_value", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetLatlngValue(&latlng.LatLng{Latitude: 48.8566, Longitude: 2.3522})
+				return &result
+			},
+		},
+		{
+			name:          "LatLng from LINESTRING",
+			row:           []bigquery.Value{"LINESTRING(-122.48369 37.8331628, -122.48348 37.8347766)"},
+			schema:        bigquery.Schema{{Name: "latlng_value", Type: bigquery.GeographyFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:   "line string from WKT",
+			row:    []bigquery.Value{"LINESTRING(-122.48369 37.8331628, -122.48348 37.8347766)"},
+			schema: bigquery.Schema{{Name: "line_string", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				var lineString testdatav1.LineString
+				lineString.SetPoints(points())
+				result.SetLineString(&lineString)
+				return &result
+			},
+		},
+		{
+			name:   "line string from GeoJSON",
+			row:    []bigquery.Value{`{"type": "LineString", "coordinates": [[-122.48369, 37.8331628], [-122.48348, 37.8347766]]}`},
+			schema: bigquery.Schema{{Name: "line_string", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				var lineString testdatav1.LineString
+				lineString.SetPoints(points())
+				result.SetLineString(&lineString)
+				return &result
+			},
+		},
+		{
+			name:   "empty line string",
+			row:    []bigquery.Value{"LINESTRING EMPTY"},
+			schema: bigquery.Schema{{Name: "line_string", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetLineString(&testdatav1.LineString{})
+				return &result
+			},
+		},
+		{
+			name:   "multi point",
+			row:    []bigquery.Value{"MULTIPOINT((-122.48369 37.8331628), -122.48348 37.8347766)"},
+			schema: bigquery.Schema{{Name: "multi_point", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				var multiPoint testdatav1.MultiPoint
+				multiPoint.SetPoints(points())
+				result.SetMultiPoint(&multiPoint)
+				return &result
+			},
+		},
+		{
+			name:   "polygon with hole",
+			row:    []bigquery.Value{"POLYGON((0 0, 10 0, 10 10, 0 0), (1 1, 2 1, 2 2, 1 1))"},
+			schema: bigquery.Schema{{Name: "polygon", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetPolygon(newPolygon(
+					newRing(
+						&latlng.LatLng{Latitude: 0, Longitude: 0},
+						&latlng.LatLng{Latitude: 0, Longitude: 10},
+						&latlng.LatLng{Latitude: 10, Longitude: 10},
+						&latlng.LatLng{Latitude: 0, Longitude: 0},
+					),
+					newRing(
+						&latlng.LatLng{Latitude: 1, Longitude: 1},
+						&latlng.LatLng{Latitude: 1, Longitude: 2},
+						&latlng.LatLng{Latitude: 2, Longitude: 2},
+						&latlng.LatLng{Latitude: 1, Longitude: 1},
+					),
+				))
+				return &result
+			},
+		},
+		{
+			name: "repeated polygons",
+			row: []bigquery.Value{[]bigquery.Value{
+				"POLYGON((0 0, 1 0, 0 1, 0 0))",
+				`{"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [0, 1], [0, 0]]]}`,
+			}},
+			schema: bigquery.Schema{{Name: "repeated_polygon", Type: bigquery.GeographyFieldType, Repeated: true}},
+			expected: func() proto.Message {
+				newTriangle := func() *testdatav1.Polygon {
+					return newPolygon(newRing(
+						&latlng.LatLng{Latitude: 0, Longitude: 0},
+						&latlng.LatLng{Latitude: 0, Longitude: 1},
+						&latlng.LatLng{Latitude: 1, Longitude: 0},
+						&latlng.LatLng{Latitude: 0, Longitude: 0},
+					))
+				}
+				var result testdatav1.KitchenSink
+				result.SetRepeatedPolygon([]*testdatav1.Polygon{newTriangle(), newTriangle()})
+				return &result
+			},
+		},
+		{
+			name:   "line string by option",
+			row:    []bigquery.Value{"LINESTRING(-122.48369 37.8331628, -122.48348 37.8347766)"},
+			schema: bigquery.Schema{{Name: "route", Type: bigquery.GeographyFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				var route testdatav1.Route
+				route.SetWaypoints(points())
+				result.SetRoute(&route)
+				return &result
+			},
+		},
+		{
+			name:          "multi point into line string by option",
+			row:           []bigquery.Value{"MULTIPOINT(-122.48369 37.8331628, -122.48348 37.8347766)"},
+			schema:        bigquery.Schema{{Name: "route", Type: bigquery.GeographyFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:          "polygon into line string",
+			row:           []bigquery.Value{"POLYGON((0 0, 1 0, 0 1, 0 0))"},
+			schema:        bigquery.Schema{{Name: "line_string", Type: bigquery.GeographyFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:          "invalid WKT",
+			row:           []bigquery.Value{"LINESTRING(1 2, 3)"},
+			schema:        bigquery.Schema{{Name: "line_string", Type: bigquery.GeographyFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unsupported value",
+			row:           []bigquery.Value{int64(1)},
+			schema:        bigquery.Schema{{Name: "polygon", Type: bigquery.GeographyFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseGeography_errors(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"POINT",
+		"POINT EMPTY",
+		"POINT Z (1 2 3)",
+		"POINT(1 2 3)",
+		"POINT(1)",
+		"POINT(1 2",
+		"POINT(1 2) POINT(3 4)",
+		"POINT(181 0)",
+		"POINT(0 -91)",
+		"POINT(x y)",
+		"LINESTRING()",
+		"LINESTRING((1 2))",
+		"POLYGON(0 0, 1 0, 0 1, 0 0)",
+		"POLYGON((0 0, 1 0, 0 1, 0 0)",
+		"MULTIPOLYGON(((0 0, 1 0, 0 1, 0 0)))",
+		`{"type": "Point"}`,
+		`{"type": "Point", "coordinates": [1, 2, 3]}`,
+		`{"type": "Point", "coordinates": [[1, 2]]}`,
+		`{"type": "LineString", "coordinates": [1, 2]}`,
+		`{"type": "Feature", "coordinates": [1, 2]}`,
+		`{"type": "Point", "coordinates": [1, 2]`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			if actual, err := parseGeography(input); err == nil {
+				t.Errorf("expected error, got %v %v %v", actual, actual.points, actual.rings)
+			}
+		})
+	}
+}
@@ -7,13 +7,19 @@
 package testdatav1
 
 import (
+	_ "github.com/way-platform/protobq-go/protobqpb"
 	date "google.golang.org/genproto/googleapis/type/date"
 	datetime "google.golang.org/genproto/googleapis/type/datetime"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	fraction "google.golang.org/genproto/googleapis/type/fraction"
 	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
 	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -139,8 +145,34 @@ type KitchenSink struct {
 	xxx_hidden_MapStringDate          map[string]*date.Date              `protobuf:"bytes,62,rep,name=map_string_date,json=mapStringDate" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	xxx_hidden_MapStringDatetime      map[string]*datetime.DateTime      `protobuf:"bytes,63,rep,name=map_string_datetime,json=mapStringDatetime" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	xxx_hidden_MapStringTimeofday     map[string]*timeofday.TimeOfDay    `protobuf:"bytes,64,rep,name=map_string_timeofday,json=mapStringTimeofday" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_AnyValue               *anypb.Any                         `protobuf:"bytes,65,opt,name=any_value,json=anyValue"`
+	xxx_hidden_RepeatedAny            *[]*anypb.Any                      `protobuf:"bytes,66,rep,name=repeated_any,json=repeatedAny"`
+	xxx_hidden_JsonValue              *structpb.Value                    `protobuf:"bytes,67,opt,name=json_value,json=jsonValue"`
+	xxx_hidden_ListValue              *structpb.ListValue                `protobuf:"bytes,68,opt,name=list_value,json=listValue"`
+	xxx_hidden_StructValue            *structpb.Struct                   `protobuf:"bytes,69,opt,name=struct_value,json=structValue"`
+	xxx_hidden_NullValue              structpb.NullValue                 `protobuf:"varint,70,opt,name=null_value,json=nullValue,enum=google.protobuf.NullValue"`
+	xxx_hidden_RepeatedValue          *[]*structpb.Value                 `protobuf:"bytes,71,rep,name=repeated_value,json=repeatedValue"`
+	xxx_hidden_MapStringValue         map[string]*structpb.Value         `protobuf:"bytes,72,rep,name=map_string_value,json=mapStringValue" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_DecimalValue           *decimal.Decimal                   `protobuf:"bytes,73,opt,name=decimal_value,json=decimalValue"`
+	xxx_hidden_FractionValue          *fraction.Fraction                 `protobuf:"bytes,74,opt,name=fraction_value,json=fractionValue"`
+	xxx_hidden_MoneyValue             *money.Money                       `protobuf:"bytes,75,opt,name=money_value,json=moneyValue"`
+	xxx_hidden_Price                  *money.Money                       `protobuf:"bytes,76,opt,name=price"`
+	xxx_hidden_RepeatedDecimal        *[]*decimal.Decimal                `protobuf:"bytes,77,rep,name=repeated_decimal,json=repeatedDecimal"`
+	xxx_hidden_MapStringDecimal       map[string]*decimal.Decimal        `protobuf:"bytes,78,rep,name=map_string_decimal,json=mapStringDecimal" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_IntervalValue          *Interval                          `protobuf:"bytes,79,opt,name=interval_value,json=intervalValue"`
+	xxx_hidden_MonthDayNanos          *MonthDayNanos                     `protobuf:"bytes,80,opt,name=month_day_nanos,json=monthDayNanos"`
+	xxx_hidden_RepeatedInterval       *[]*Interval                       `protobuf:"bytes,81,rep,name=repeated_interval,json=repeatedInterval"`
+	xxx_hidden_LineString             *LineString                        `protobuf:"bytes,82,opt,name=line_string,json=lineString"`
+	xxx_hidden_Polygon                *Polygon                           `protobuf:"bytes,83,opt,name=polygon"`
+	xxx_hidden_MultiPoint             *MultiPoint                        `protobuf:"bytes,84,opt,name=multi_point,json=multiPoint"`
+	xxx_hidden_Route                  *Route                             `protobuf:"bytes,85,opt,name=route"`
+	xxx_hidden_RepeatedPolygon        *[]*Polygon                        `protobuf:"bytes,86,rep,name=repeated_polygon,json=repeatedPolygon"`
+	xxx_hidden_TimestampMillis        *timestamppb.Timestamp             `protobuf:"bytes,87,opt,name=timestamp_millis,json=timestampMillis"`
+	xxx_hidden_DurationMillis         *durationpb.Duration               `protobuf:"bytes,88,opt,name=duration_millis,json=durationMillis"`
+	xxx_hidden_UnixSeconds            int64                              `protobuf:"varint,89,opt,name=unix_seconds,json=unixSeconds"`
+	xxx_hidden_RepeatedTimestampNanos *[]*timestamppb.Timestamp          `protobuf:"bytes,90,rep,name=repeated_timestamp_nanos,json=repeatedTimestampNanos"`
 	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
-	XXX_presence                      [2]uint32
+	XXX_presence                      [3]uint32
 	unknownFields                     protoimpl.UnknownFields
 	sizeCache                         protoimpl.SizeCache
 }
@@ -654,74 +686,270 @@ func (x *KitchenSink) GetMapStringTimeofday() map[string]*timeofday.TimeOfDay {
 	return nil
 }
 
+func (x *KitchenSink) GetAnyValue() *anypb.Any {
+	if x != nil {
+		return x.xxx_hidden_AnyValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRepeatedAny() []*anypb.Any {
+	if x != nil {
+		if x.xxx_hidden_RepeatedAny != nil {
+			return *x.xxx_hidden_RepeatedAny
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetJsonValue() *structpb.Value {
+	if x != nil {
+		return x.xxx_hidden_JsonValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetListValue() *structpb.ListValue {
+	if x != nil {
+		return x.xxx_hidden_ListValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetStructValue() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_StructValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetNullValue() structpb.NullValue {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[2]), 69) {
+			return x.xxx_hidden_NullValue
+		}
+	}
+	return structpb.NullValue(0)
+}
+
+func (x *KitchenSink) GetRepeatedValue() []*structpb.Value {
+	if x != nil {
+		if x.xxx_hidden_RepeatedValue != nil {
+			return *x.xxx_hidden_RepeatedValue
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMapStringValue() map[string]*structpb.Value {
+	if x != nil {
+		return x.xxx_hidden_MapStringValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetDecimalValue() *decimal.Decimal {
+	if x != nil {
+		return x.xxx_hidden_DecimalValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetFractionValue() *fraction.Fraction {
+	if x != nil {
+		return x.xxx_hidden_FractionValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMoneyValue() *money.Money {
+	if x != nil {
+		return x.xxx_hidden_MoneyValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetPrice() *money.Money {
+	if x != nil {
+		return x.xxx_hidden_Price
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRepeatedDecimal() []*decimal.Decimal {
+	if x != nil {
+		if x.xxx_hidden_RepeatedDecimal != nil {
+			return *x.xxx_hidden_RepeatedDecimal
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMapStringDecimal() map[string]*decimal.Decimal {
+	if x != nil {
+		return x.xxx_hidden_MapStringDecimal
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetIntervalValue() *Interval {
+	if x != nil {
+		return x.xxx_hidden_IntervalValue
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMonthDayNanos() *MonthDayNanos {
+	if x != nil {
+		return x.xxx_hidden_MonthDayNanos
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRepeatedInterval() []*Interval {
+	if x != nil {
+		if x.xxx_hidden_RepeatedInterval != nil {
+			return *x.xxx_hidden_RepeatedInterval
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetLineString() *LineString {
+	if x != nil {
+		return x.xxx_hidden_LineString
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetPolygon() *Polygon {
+	if x != nil {
+		return x.xxx_hidden_Polygon
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetMultiPoint() *MultiPoint {
+	if x != nil {
+		return x.xxx_hidden_MultiPoint
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRoute() *Route {
+	if x != nil {
+		return x.xxx_hidden_Route
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetRepeatedPolygon() []*Polygon {
+	if x != nil {
+		if x.xxx_hidden_RepeatedPolygon != nil {
+			return *x.xxx_hidden_RepeatedPolygon
+		}
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetTimestampMillis() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_TimestampMillis
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetDurationMillis() *durationpb.Duration {
+	if x != nil {
+		return x.xxx_hidden_DurationMillis
+	}
+	return nil
+}
+
+func (x *KitchenSink) GetUnixSeconds() int64 {
+	if x != nil {
+		return x.xxx_hidden_UnixSeconds
+	}
+	return 0
+}
+
+func (x *KitchenSink) GetRepeatedTimestampNanos() []*timestamppb.Timestamp {
+	if x != nil {
+		if x.xxx_hidden_RepeatedTimestampNanos != nil {
+			return *x.xxx_hidden_RepeatedTimestampNanos
+		}
+	}
+	return nil
+}
+
 func (x *KitchenSink) SetDoubleValue(v float64) {
 	x.xxx_hidden_DoubleValue = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 90)
 }
 
 func (x *KitchenSink) SetFloatValue(v float32) {
 	x.xxx_hidden_FloatValue = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 90)
 }
 
 func (x *KitchenSink) SetInt32Value(v int32) {
 	x.xxx_hidden_Int32Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 90)
 }
 
 func (x *KitchenSink) SetInt64Value(v int64) {
 	x.xxx_hidden_Int64Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 90)
 }
 
 func (x *KitchenSink) SetSint32Value(v int32) {
 	x.xxx_hidden_Sint32Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 90)
 }
 
 func (x *KitchenSink) SetSint64Value(v int64) {
 	x.xxx_hidden_Sint64Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 90)
 }
 
 func (x *KitchenSink) SetUint32Value(v uint32) {
 	x.xxx_hidden_Uint32Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 90)
 }
 
 func (x *KitchenSink) SetUint64Value(v uint64) {
 	x.xxx_hidden_Uint64Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 90)
 }
 
 func (x *KitchenSink) SetFixed32Value(v uint32) {
 	x.xxx_hidden_Fixed32Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 90)
 }
 
 func (x *KitchenSink) SetFixed64Value(v uint64) {
 	x.xxx_hidden_Fixed64Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 90)
 }
 
 func (x *KitchenSink) SetSfixed32Value(v int32) {
 	x.xxx_hidden_Sfixed32Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 90)
 }
 
 func (x *KitchenSink) SetSfixed64Value(v int64) {
 	x.xxx_hidden_Sfixed64Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 90)
 }
 
 func (x *KitchenSink) SetBoolValue(v bool) {
 	x.xxx_hidden_BoolValue = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 90)
 }
 
 func (x *KitchenSink) SetStringValue(v string) {
 	x.xxx_hidden_StringValue = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 90)
 }
 
 func (x *KitchenSink) SetBytesValue(v []byte) {
@@ -729,12 +957,12 @@ func (x *KitchenSink) SetBytesValue(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_BytesValue = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 90)
 }
 
 func (x *KitchenSink) SetEnumValue(v TestEnum) {
 	x.xxx_hidden_EnumValue = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 90)
 }
 
 func (x *KitchenSink) SetRepeatedString(v []string) {
@@ -855,17 +1083,17 @@ func (x *KitchenSink) SetLatlngValue(v *latlng.LatLng) {
 
 func (x *KitchenSink) SetGeographyString(v string) {
 	x.xxx_hidden_GeographyString = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 45, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 45, 90)
 }
 
 func (x *KitchenSink) SetDurationString(v string) {
 	x.xxx_hidden_DurationString = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 46, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 46, 90)
 }
 
 func (x *KitchenSink) SetIntervalString(v string) {
 	x.xxx_hidden_IntervalString = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 47, 64)
+	protoimpl.X.SetPresent(&(x.XXX_presence[1]), 47, 90)
 }
 
 func (x *KitchenSink) SetDateRange(v *DateRange) {
@@ -932,6 +1160,112 @@ func (x *KitchenSink) SetMapStringTimeofday(v map[string]*timeofday.TimeOfDay) {
 	x.xxx_hidden_MapStringTimeofday = v
 }
 
+func (x *KitchenSink) SetAnyValue(v *anypb.Any) {
+	x.xxx_hidden_AnyValue = v
+}
+
+func (x *KitchenSink) SetRepeatedAny(v []*anypb.Any) {
+	x.xxx_hidden_RepeatedAny = &v
+}
+
+func (x *KitchenSink) SetJsonValue(v *structpb.Value) {
+	x.xxx_hidden_JsonValue = v
+}
+
+func (x *KitchenSink) SetListValue(v *structpb.ListValue) {
+	x.xxx_hidden_ListValue = v
+}
+
+func (x *KitchenSink) SetStructValue(v *structpb.Struct) {
+	x.xxx_hidden_StructValue = v
+}
+
+func (x *KitchenSink) SetNullValue(v structpb.NullValue) {
+	x.xxx_hidden_NullValue = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[2]), 69, 90)
+}
+
+func (x *KitchenSink) SetRepeatedValue(v []*structpb.Value) {
+	x.xxx_hidden_RepeatedValue = &v
+}
+
+func (x *KitchenSink) SetMapStringValue(v map[string]*structpb.Value) {
+	x.xxx_hidden_MapStringValue = v
+}
+
+func (x *KitchenSink) SetDecimalValue(v *decimal.Decimal) {
+	x.xxx_hidden_DecimalValue = v
+}
+
+func (x *KitchenSink) SetFractionValue(v *fraction.Fraction) {
+	x.xxx_hidden_FractionValue = v
+}
+
+func (x *KitchenSink) SetMoneyValue(v *money.Money) {
+	x.xxx_hidden_MoneyValue = v
+}
+
+func (x *KitchenSink) SetPrice(v *money.Money) {
+	x.xxx_hidden_Price = v
+}
+
+func (x *KitchenSink) SetRepeatedDecimal(v []*decimal.Decimal) {
+	x.xxx_hidden_RepeatedDecimal = &v
+}
+
+func (x *KitchenSink) SetMapStringDecimal(v map[string]*decimal.Decimal) {
+	x.xxx_hidden_MapStringDecimal = v
+}
+
+func (x *KitchenSink) SetIntervalValue(v *Interval) {
+	x.xxx_hidden_IntervalValue = v
+}
+
+func (x *KitchenSink) SetMonthDayNanos(v *MonthDayNanos) {
+	x.xxx_hidden_MonthDayNanos = v
+}
+
+func (x *KitchenSink) SetRepeatedInterval(v []*Interval) {
+	x.xxx_hidden_RepeatedInterval = &v
+}
+
+func (x *KitchenSink) SetLineString(v *LineString) {
+	x.xxx_hidden_LineString = v
+}
+
+func (x *KitchenSink) SetPolygon(v *Polygon) {
+	x.xxx_hidden_Polygon = v
+}
+
+func (x *KitchenSink) SetMultiPoint(v *MultiPoint) {
+	x.xxx_hidden_MultiPoint = v
+}
+
+func (x *KitchenSink) SetRoute(v *Route) {
+	x.xxx_hidden_Route = v
+}
+
+func (x *KitchenSink) SetRepeatedPolygon(v []*Polygon) {
+	x.xxx_hidden_RepeatedPolygon = &v
+}
+
+func (x *KitchenSink) SetTimestampMillis(v *timestamppb.Timestamp) {
+	x.xxx_hidden_TimestampMillis = v
+}
+
+func (x *KitchenSink) SetDurationMillis(v *durationpb.Duration) {
+	x.xxx_hidden_DurationMillis = v
+}
+
+func (x *KitchenSink) SetUnixSeconds(v int64) {
+	x.xxx_hidden_UnixSeconds = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[2]), 88, 90)
+}
+
+func (x *KitchenSink) SetRepeatedTimestampNanos(v []*timestamppb.Timestamp) {
+	x.xxx_hidden_RepeatedTimestampNanos = &v
+}
+
 func (x *KitchenSink) HasDoubleValue() bool {
 	if x == nil {
 		return false
@@ -1198,63 +1532,189 @@ func (x *KitchenSink) HasDatetimeRange() bool {
 	return x.xxx_hidden_DatetimeRange != nil
 }
 
-func (x *KitchenSink) ClearDoubleValue() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_DoubleValue = 0
+func (x *KitchenSink) HasAnyValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_AnyValue != nil
 }
 
-func (x *KitchenSink) ClearFloatValue() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_FloatValue = 0
+func (x *KitchenSink) HasJsonValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_JsonValue != nil
 }
 
-func (x *KitchenSink) ClearInt32Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Int32Value = 0
+func (x *KitchenSink) HasListValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ListValue != nil
 }
 
-func (x *KitchenSink) ClearInt64Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Int64Value = 0
+func (x *KitchenSink) HasStructValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_StructValue != nil
 }
 
-func (x *KitchenSink) ClearSint32Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Sint32Value = 0
+func (x *KitchenSink) HasNullValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[2]), 69)
 }
 
-func (x *KitchenSink) ClearSint64Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_Sint64Value = 0
+func (x *KitchenSink) HasDecimalValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DecimalValue != nil
 }
 
-func (x *KitchenSink) ClearUint32Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_Uint32Value = 0
+func (x *KitchenSink) HasFractionValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_FractionValue != nil
 }
 
-func (x *KitchenSink) ClearUint64Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_Uint64Value = 0
+func (x *KitchenSink) HasMoneyValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MoneyValue != nil
 }
 
-func (x *KitchenSink) ClearFixed32Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
-	x.xxx_hidden_Fixed32Value = 0
+func (x *KitchenSink) HasPrice() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Price != nil
 }
 
-func (x *KitchenSink) ClearFixed64Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
-	x.xxx_hidden_Fixed64Value = 0
+func (x *KitchenSink) HasIntervalValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_IntervalValue != nil
 }
 
-func (x *KitchenSink) ClearSfixed32Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
-	x.xxx_hidden_Sfixed32Value = 0
+func (x *KitchenSink) HasMonthDayNanos() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MonthDayNanos != nil
 }
 
-func (x *KitchenSink) ClearSfixed64Value() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+func (x *KitchenSink) HasLineString() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LineString != nil
+}
+
+func (x *KitchenSink) HasPolygon() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Polygon != nil
+}
+
+func (x *KitchenSink) HasMultiPoint() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_MultiPoint != nil
+}
+
+func (x *KitchenSink) HasRoute() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Route != nil
+}
+
+func (x *KitchenSink) HasTimestampMillis() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TimestampMillis != nil
+}
+
+func (x *KitchenSink) HasDurationMillis() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DurationMillis != nil
+}
+
+func (x *KitchenSink) HasUnixSeconds() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[2]), 88)
+}
+
+func (x *KitchenSink) ClearDoubleValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DoubleValue = 0
+}
+
+func (x *KitchenSink) ClearFloatValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_FloatValue = 0
+}
+
+func (x *KitchenSink) ClearInt32Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Int32Value = 0
+}
+
+func (x *KitchenSink) ClearInt64Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Int64Value = 0
+}
+
+func (x *KitchenSink) ClearSint32Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Sint32Value = 0
+}
+
+func (x *KitchenSink) ClearSint64Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Sint64Value = 0
+}
+
+func (x *KitchenSink) ClearUint32Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Uint32Value = 0
+}
+
+func (x *KitchenSink) ClearUint64Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Uint64Value = 0
+}
+
+func (x *KitchenSink) ClearFixed32Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Fixed32Value = 0
+}
+
+func (x *KitchenSink) ClearFixed64Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Fixed64Value = 0
+}
+
+func (x *KitchenSink) ClearSfixed32Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Sfixed32Value = 0
+}
+
+func (x *KitchenSink) ClearSfixed64Value() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
 	x.xxx_hidden_Sfixed64Value = 0
 }
 
@@ -1369,6 +1829,80 @@ func (x *KitchenSink) ClearDatetimeRange() {
 	x.xxx_hidden_DatetimeRange = nil
 }
 
+func (x *KitchenSink) ClearAnyValue() {
+	x.xxx_hidden_AnyValue = nil
+}
+
+func (x *KitchenSink) ClearJsonValue() {
+	x.xxx_hidden_JsonValue = nil
+}
+
+func (x *KitchenSink) ClearListValue() {
+	x.xxx_hidden_ListValue = nil
+}
+
+func (x *KitchenSink) ClearStructValue() {
+	x.xxx_hidden_StructValue = nil
+}
+
+func (x *KitchenSink) ClearNullValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[2]), 69)
+	x.xxx_hidden_NullValue = structpb.NullValue_NULL_VALUE
+}
+
+func (x *KitchenSink) ClearDecimalValue() {
+	x.xxx_hidden_DecimalValue = nil
+}
+
+func (x *KitchenSink) ClearFractionValue() {
+	x.xxx_hidden_FractionValue = nil
+}
+
+func (x *KitchenSink) ClearMoneyValue() {
+	x.xxx_hidden_MoneyValue = nil
+}
+
+func (x *KitchenSink) ClearPrice() {
+	x.xxx_hidden_Price = nil
+}
+
+func (x *KitchenSink) ClearIntervalValue() {
+	x.xxx_hidden_IntervalValue = nil
+}
+
+func (x *KitchenSink) ClearMonthDayNanos() {
+	x.xxx_hidden_MonthDayNanos = nil
+}
+
+func (x *KitchenSink) ClearLineString() {
+	x.xxx_hidden_LineString = nil
+}
+
+func (x *KitchenSink) ClearPolygon() {
+	x.xxx_hidden_Polygon = nil
+}
+
+func (x *KitchenSink) ClearMultiPoint() {
+	x.xxx_hidden_MultiPoint = nil
+}
+
+func (x *KitchenSink) ClearRoute() {
+	x.xxx_hidden_Route = nil
+}
+
+func (x *KitchenSink) ClearTimestampMillis() {
+	x.xxx_hidden_TimestampMillis = nil
+}
+
+func (x *KitchenSink) ClearDurationMillis() {
+	x.xxx_hidden_DurationMillis = nil
+}
+
+func (x *KitchenSink) ClearUnixSeconds() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[2]), 88)
+	x.xxx_hidden_UnixSeconds = 0
+}
+
 type KitchenSink_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1454,6 +1988,38 @@ type KitchenSink_builder struct {
 	MapStringDate      map[string]*date.Date
 	MapStringDatetime  map[string]*datetime.DateTime
 	MapStringTimeofday map[string]*timeofday.TimeOfDay
+	// Any types, packed from JSON or RECORD<type_url, value> columns
+	AnyValue    *anypb.Any
+	RepeatedAny []*anypb.Any
+	// JSON types, loaded from JSON columns
+	JsonValue      *structpb.Value
+	ListValue      *structpb.ListValue
+	StructValue    *structpb.Struct
+	NullValue      *structpb.NullValue
+	RepeatedValue  []*structpb.Value
+	MapStringValue map[string]*structpb.Value
+	// Decimal types, loaded from NUMERIC and BIGNUMERIC columns
+	DecimalValue     *decimal.Decimal
+	FractionValue    *fraction.Fraction
+	MoneyValue       *money.Money
+	Price            *money.Money
+	RepeatedDecimal  []*decimal.Decimal
+	MapStringDecimal map[string]*decimal.Decimal
+	// Interval types, loaded from INTERVAL columns
+	IntervalValue    *Interval
+	MonthDayNanos    *MonthDayNanos
+	RepeatedInterval []*Interval
+	// Geometry types, loaded from GEOGRAPHY columns
+	LineString      *LineString
+	Polygon         *Polygon
+	MultiPoint      *MultiPoint
+	Route           *Route
+	RepeatedPolygon []*Polygon
+	// Epoch units, loaded from INTEGER, TIMESTAMP and DATE columns
+	TimestampMillis        *timestamppb.Timestamp
+	DurationMillis         *durationpb.Duration
+	UnixSeconds            *int64
+	RepeatedTimestampNanos []*timestamppb.Timestamp
 }
 
 func (b0 KitchenSink_builder) Build() *KitchenSink {
@@ -1461,67 +2027,67 @@ func (b0 KitchenSink_builder) Build() *KitchenSink {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.DoubleValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 90)
 		x.xxx_hidden_DoubleValue = *b.DoubleValue
 	}
 	if b.FloatValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 90)
 		x.xxx_hidden_FloatValue = *b.FloatValue
 	}
 	if b.Int32Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 90)
 		x.xxx_hidden_Int32Value = *b.Int32Value
 	}
 	if b.Int64Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 90)
 		x.xxx_hidden_Int64Value = *b.Int64Value
 	}
 	if b.Sint32Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 90)
 		x.xxx_hidden_Sint32Value = *b.Sint32Value
 	}
 	if b.Sint64Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 90)
 		x.xxx_hidden_Sint64Value = *b.Sint64Value
 	}
 	if b.Uint32Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 90)
 		x.xxx_hidden_Uint32Value = *b.Uint32Value
 	}
 	if b.Uint64Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 90)
 		x.xxx_hidden_Uint64Value = *b.Uint64Value
 	}
 	if b.Fixed32Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 90)
 		x.xxx_hidden_Fixed32Value = *b.Fixed32Value
 	}
 	if b.Fixed64Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 90)
 		x.xxx_hidden_Fixed64Value = *b.Fixed64Value
 	}
 	if b.Sfixed32Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 90)
 		x.xxx_hidden_Sfixed32Value = *b.Sfixed32Value
 	}
 	if b.Sfixed64Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 90)
 		x.xxx_hidden_Sfixed64Value = *b.Sfixed64Value
 	}
 	if b.BoolValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 90)
 		x.xxx_hidden_BoolValue = *b.BoolValue
 	}
 	if b.StringValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 90)
 		x.xxx_hidden_StringValue = b.StringValue
 	}
 	if b.BytesValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 90)
 		x.xxx_hidden_BytesValue = b.BytesValue
 	}
 	if b.EnumValue != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 90)
 		x.xxx_hidden_EnumValue = *b.EnumValue
 	}
 	x.xxx_hidden_RepeatedString = b.RepeatedString
@@ -1554,15 +2120,15 @@ func (b0 KitchenSink_builder) Build() *KitchenSink {
 	x.xxx_hidden_MapStringTimestamp = b.MapStringTimestamp
 	x.xxx_hidden_LatlngValue = b.LatlngValue
 	if b.GeographyString != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 45, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 45, 90)
 		x.xxx_hidden_GeographyString = b.GeographyString
 	}
 	if b.DurationString != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 46, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 46, 90)
 		x.xxx_hidden_DurationString = b.DurationString
 	}
 	if b.IntervalString != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 47, 64)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[1]), 47, 90)
 		x.xxx_hidden_IntervalString = b.IntervalString
 	}
 	x.xxx_hidden_DateRange = b.DateRange
@@ -1581,6 +2147,38 @@ func (b0 KitchenSink_builder) Build() *KitchenSink {
 	x.xxx_hidden_MapStringDate = b.MapStringDate
 	x.xxx_hidden_MapStringDatetime = b.MapStringDatetime
 	x.xxx_hidden_MapStringTimeofday = b.MapStringTimeofday
+	x.xxx_hidden_AnyValue = b.AnyValue
+	x.xxx_hidden_RepeatedAny = &b.RepeatedAny
+	x.xxx_hidden_JsonValue = b.JsonValue
+	x.xxx_hidden_ListValue = b.ListValue
+	x.xxx_hidden_StructValue = b.StructValue
+	if b.NullValue != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[2]), 69, 90)
+		x.xxx_hidden_NullValue = *b.NullValue
+	}
+	x.xxx_hidden_RepeatedValue = &b.RepeatedValue
+	x.xxx_hidden_MapStringValue = b.MapStringValue
+	x.xxx_hidden_DecimalValue = b.DecimalValue
+	x.xxx_hidden_FractionValue = b.FractionValue
+	x.xxx_hidden_MoneyValue = b.MoneyValue
+	x.xxx_hidden_Price = b.Price
+	x.xxx_hidden_RepeatedDecimal = &b.RepeatedDecimal
+	x.xxx_hidden_MapStringDecimal = b.MapStringDecimal
+	x.xxx_hidden_IntervalValue = b.IntervalValue
+	x.xxx_hidden_MonthDayNanos = b.MonthDayNanos
+	x.xxx_hidden_RepeatedInterval = &b.RepeatedInterval
+	x.xxx_hidden_LineString = b.LineString
+	x.xxx_hidden_Polygon = b.Polygon
+	x.xxx_hidden_MultiPoint = b.MultiPoint
+	x.xxx_hidden_Route = b.Route
+	x.xxx_hidden_RepeatedPolygon = &b.RepeatedPolygon
+	x.xxx_hidden_TimestampMillis = b.TimestampMillis
+	x.xxx_hidden_DurationMillis = b.DurationMillis
+	if b.UnixSeconds != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[2]), 88, 90)
+		x.xxx_hidden_UnixSeconds = *b.UnixSeconds
+	}
+	x.xxx_hidden_RepeatedTimestampNanos = &b.RepeatedTimestampNanos
 	return m0
 }
 
@@ -1902,34 +2500,36 @@ func (b0 DateTimeRange_builder) Build() *DateTimeRange {
 	return m0
 }
 
-// Nested message for testing purposes
-type NestedMessage struct {
-	state                    protoimpl.MessageState        `protogen:"opaque.v1"`
-	xxx_hidden_Text          *string                       `protobuf:"bytes,1,opt,name=text"`
-	xxx_hidden_Number        int32                         `protobuf:"varint,2,opt,name=number"`
-	xxx_hidden_Flag          bool                          `protobuf:"varint,3,opt,name=flag"`
-	xxx_hidden_Tags          []string                      `protobuf:"bytes,4,rep,name=tags"`
-	xxx_hidden_OptionalValue isNestedMessage_OptionalValue `protobuf_oneof:"optional_value"`
-	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
-	XXX_presence             [1]uint32
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// Represents a BigQuery INTERVAL type with all of its parts
+type Interval struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Years       int32                  `protobuf:"varint,1,opt,name=years"`
+	xxx_hidden_Months      int32                  `protobuf:"varint,2,opt,name=months"`
+	xxx_hidden_Days        int32                  `protobuf:"varint,3,opt,name=days"`
+	xxx_hidden_Hours       int32                  `protobuf:"varint,4,opt,name=hours"`
+	xxx_hidden_Minutes     int32                  `protobuf:"varint,5,opt,name=minutes"`
+	xxx_hidden_Seconds     int32                  `protobuf:"varint,6,opt,name=seconds"`
+	xxx_hidden_Nanos       int32                  `protobuf:"varint,7,opt,name=nanos"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *NestedMessage) Reset() {
-	*x = NestedMessage{}
+func (x *Interval) Reset() {
+	*x = Interval{}
 	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NestedMessage) String() string {
+func (x *Interval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NestedMessage) ProtoMessage() {}
+func (*Interval) ProtoMessage() {}
 
-func (x *NestedMessage) ProtoReflect() protoreflect.Message {
+func (x *Interval) ProtoReflect() protoreflect.Message {
 	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1941,369 +2541,1631 @@ func (x *NestedMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *NestedMessage) GetText() string {
-	if x != nil {
-		if x.xxx_hidden_Text != nil {
-			return *x.xxx_hidden_Text
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *NestedMessage) GetNumber() int32 {
+func (x *Interval) GetYears() int32 {
 	if x != nil {
-		return x.xxx_hidden_Number
+		return x.xxx_hidden_Years
 	}
 	return 0
 }
 
-func (x *NestedMessage) GetFlag() bool {
-	if x != nil {
-		return x.xxx_hidden_Flag
-	}
-	return false
-}
-
-func (x *NestedMessage) GetTags() []string {
+func (x *Interval) GetMonths() int32 {
 	if x != nil {
-		return x.xxx_hidden_Tags
+		return x.xxx_hidden_Months
 	}
-	return nil
+	return 0
 }
 
-func (x *NestedMessage) GetStringOption() string {
+func (x *Interval) GetDays() int32 {
 	if x != nil {
-		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption); ok {
-			return x.StringOption
-		}
+		return x.xxx_hidden_Days
 	}
-	return ""
+	return 0
 }
 
-func (x *NestedMessage) GetIntOption() int64 {
+func (x *Interval) GetHours() int32 {
 	if x != nil {
-		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption); ok {
-			return x.IntOption
-		}
+		return x.xxx_hidden_Hours
 	}
 	return 0
 }
 
-func (x *NestedMessage) GetBoolOption() bool {
+func (x *Interval) GetMinutes() int32 {
 	if x != nil {
-		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption); ok {
-			return x.BoolOption
-		}
+		return x.xxx_hidden_Minutes
 	}
-	return false
+	return 0
 }
 
-func (x *NestedMessage) GetTimestampOption() *timestamppb.Timestamp {
+func (x *Interval) GetSeconds() int32 {
 	if x != nil {
-		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_TimestampOption); ok {
-			return x.TimestampOption
-		}
+		return x.xxx_hidden_Seconds
 	}
-	return nil
+	return 0
 }
 
-func (x *NestedMessage) GetComplexOption() *NestedMessage_ComplexValue {
+func (x *Interval) GetNanos() int32 {
 	if x != nil {
-		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption); ok {
-			return x.ComplexOption
-		}
+		return x.xxx_hidden_Nanos
 	}
-	return nil
-}
-
-func (x *NestedMessage) SetText(v string) {
-	x.xxx_hidden_Text = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
-}
-
-func (x *NestedMessage) SetNumber(v int32) {
-	x.xxx_hidden_Number = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+	return 0
 }
 
-func (x *NestedMessage) SetFlag(v bool) {
-	x.xxx_hidden_Flag = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+func (x *Interval) SetYears(v int32) {
+	x.xxx_hidden_Years = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
 }
 
-func (x *NestedMessage) SetTags(v []string) {
-	x.xxx_hidden_Tags = v
+func (x *Interval) SetMonths(v int32) {
+	x.xxx_hidden_Months = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
 }
 
-func (x *NestedMessage) SetStringOption(v string) {
-	x.xxx_hidden_OptionalValue = &nestedMessage_StringOption{v}
+func (x *Interval) SetDays(v int32) {
+	x.xxx_hidden_Days = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
 }
 
-func (x *NestedMessage) SetIntOption(v int64) {
-	x.xxx_hidden_OptionalValue = &nestedMessage_IntOption{v}
+func (x *Interval) SetHours(v int32) {
+	x.xxx_hidden_Hours = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
 }
 
-func (x *NestedMessage) SetBoolOption(v bool) {
-	x.xxx_hidden_OptionalValue = &nestedMessage_BoolOption{v}
+func (x *Interval) SetMinutes(v int32) {
+	x.xxx_hidden_Minutes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
 }
 
-func (x *NestedMessage) SetTimestampOption(v *timestamppb.Timestamp) {
-	if v == nil {
-		x.xxx_hidden_OptionalValue = nil
-		return
-	}
-	x.xxx_hidden_OptionalValue = &nestedMessage_TimestampOption{v}
+func (x *Interval) SetSeconds(v int32) {
+	x.xxx_hidden_Seconds = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
 }
 
-func (x *NestedMessage) SetComplexOption(v *NestedMessage_ComplexValue) {
-	if v == nil {
-		x.xxx_hidden_OptionalValue = nil
-		return
-	}
-	x.xxx_hidden_OptionalValue = &nestedMessage_ComplexOption{v}
+func (x *Interval) SetNanos(v int32) {
+	x.xxx_hidden_Nanos = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
 }
 
-func (x *NestedMessage) HasText() bool {
+func (x *Interval) HasYears() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *NestedMessage) HasNumber() bool {
+func (x *Interval) HasMonths() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *NestedMessage) HasFlag() bool {
+func (x *Interval) HasDays() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *NestedMessage) HasOptionalValue() bool {
+func (x *Interval) HasHours() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_OptionalValue != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *NestedMessage) HasStringOption() bool {
+func (x *Interval) HasMinutes() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption)
-	return ok
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
-func (x *NestedMessage) HasIntOption() bool {
+func (x *Interval) HasSeconds() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption)
-	return ok
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
-func (x *NestedMessage) HasBoolOption() bool {
+func (x *Interval) HasNanos() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption)
-	return ok
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
-func (x *NestedMessage) HasTimestampOption() bool {
-	if x == nil {
-		return false
-	}
+func (x *Interval) ClearYears() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Years = 0
+}
+
+func (x *Interval) ClearMonths() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Months = 0
+}
+
+func (x *Interval) ClearDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Days = 0
+}
+
+func (x *Interval) ClearHours() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Hours = 0
+}
+
+func (x *Interval) ClearMinutes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Minutes = 0
+}
+
+func (x *Interval) ClearSeconds() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Seconds = 0
+}
+
+func (x *Interval) ClearNanos() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Nanos = 0
+}
+
+type Interval_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Years   *int32
+	Months  *int32
+	Days    *int32
+	Hours   *int32
+	Minutes *int32
+	Seconds *int32
+	Nanos   *int32
+}
+
+func (b0 Interval_builder) Build() *Interval {
+	m0 := &Interval{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Years != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Years = *b.Years
+	}
+	if b.Months != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Months = *b.Months
+	}
+	if b.Days != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Days = *b.Days
+	}
+	if b.Hours != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_Hours = *b.Hours
+	}
+	if b.Minutes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		x.xxx_hidden_Minutes = *b.Minutes
+	}
+	if b.Seconds != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_Seconds = *b.Seconds
+	}
+	if b.Nanos != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_Nanos = *b.Nanos
+	}
+	return m0
+}
+
+// Represents a BigQuery INTERVAL type as months, days and nanoseconds, as in Arrow
+type MonthDayNanos struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Months      int32                  `protobuf:"varint,1,opt,name=months"`
+	xxx_hidden_Days        int32                  `protobuf:"varint,2,opt,name=days"`
+	xxx_hidden_Nanos       int64                  `protobuf:"varint,3,opt,name=nanos"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MonthDayNanos) Reset() {
+	*x = MonthDayNanos{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthDayNanos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthDayNanos) ProtoMessage() {}
+
+func (x *MonthDayNanos) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MonthDayNanos) GetMonths() int32 {
+	if x != nil {
+		return x.xxx_hidden_Months
+	}
+	return 0
+}
+
+func (x *MonthDayNanos) GetDays() int32 {
+	if x != nil {
+		return x.xxx_hidden_Days
+	}
+	return 0
+}
+
+func (x *MonthDayNanos) GetNanos() int64 {
+	if x != nil {
+		return x.xxx_hidden_Nanos
+	}
+	return 0
+}
+
+func (x *MonthDayNanos) SetMonths(v int32) {
+	x.xxx_hidden_Months = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *MonthDayNanos) SetDays(v int32) {
+	x.xxx_hidden_Days = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *MonthDayNanos) SetNanos(v int64) {
+	x.xxx_hidden_Nanos = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *MonthDayNanos) HasMonths() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MonthDayNanos) HasDays() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MonthDayNanos) HasNanos() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *MonthDayNanos) ClearMonths() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Months = 0
+}
+
+func (x *MonthDayNanos) ClearDays() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Days = 0
+}
+
+func (x *MonthDayNanos) ClearNanos() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Nanos = 0
+}
+
+type MonthDayNanos_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Months *int32
+	Days   *int32
+	Nanos  *int64
+}
+
+func (b0 MonthDayNanos_builder) Build() *MonthDayNanos {
+	m0 := &MonthDayNanos{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Months != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Months = *b.Months
+	}
+	if b.Days != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Days = *b.Days
+	}
+	if b.Nanos != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Nanos = *b.Nanos
+	}
+	return m0
+}
+
+// Represents a BigQuery GEOGRAPHY LINESTRING
+type LineString struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Points *[]*latlng.LatLng      `protobuf:"bytes,1,rep,name=points"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LineString) Reset() {
+	*x = LineString{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineString) ProtoMessage() {}
+
+func (x *LineString) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LineString) GetPoints() []*latlng.LatLng {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *LineString) SetPoints(v []*latlng.LatLng) {
+	x.xxx_hidden_Points = &v
+}
+
+type LineString_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Points []*latlng.LatLng
+}
+
+func (b0 LineString_builder) Build() *LineString {
+	m0 := &LineString{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Points = &b.Points
+	return m0
+}
+
+// Represents a BigQuery GEOGRAPHY POLYGON, with the exterior ring first
+type Polygon struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Rings *[]*LinearRing         `protobuf:"bytes,1,rep,name=rings"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Polygon) GetRings() []*LinearRing {
+	if x != nil {
+		if x.xxx_hidden_Rings != nil {
+			return *x.xxx_hidden_Rings
+		}
+	}
+	return nil
+}
+
+func (x *Polygon) SetRings(v []*LinearRing) {
+	x.xxx_hidden_Rings = &v
+}
+
+type Polygon_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Rings []*LinearRing
+}
+
+func (b0 Polygon_builder) Build() *Polygon {
+	m0 := &Polygon{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Rings = &b.Rings
+	return m0
+}
+
+// Represents a closed ring of a polygon
+type LinearRing struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Points *[]*latlng.LatLng      `protobuf:"bytes,1,rep,name=points"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LinearRing) Reset() {
+	*x = LinearRing{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinearRing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinearRing) ProtoMessage() {}
+
+func (x *LinearRing) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *LinearRing) GetPoints() []*latlng.LatLng {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *LinearRing) SetPoints(v []*latlng.LatLng) {
+	x.xxx_hidden_Points = &v
+}
+
+type LinearRing_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Points []*latlng.LatLng
+}
+
+func (b0 LinearRing_builder) Build() *LinearRing {
+	m0 := &LinearRing{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Points = &b.Points
+	return m0
+}
+
+// Represents a BigQuery GEOGRAPHY MULTIPOINT
+type MultiPoint struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Points *[]*latlng.LatLng      `protobuf:"bytes,1,rep,name=points"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MultiPoint) Reset() {
+	*x = MultiPoint{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiPoint) ProtoMessage() {}
+
+func (x *MultiPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *MultiPoint) GetPoints() []*latlng.LatLng {
+	if x != nil {
+		if x.xxx_hidden_Points != nil {
+			return *x.xxx_hidden_Points
+		}
+	}
+	return nil
+}
+
+func (x *MultiPoint) SetPoints(v []*latlng.LatLng) {
+	x.xxx_hidden_Points = &v
+}
+
+type MultiPoint_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Points []*latlng.LatLng
+}
+
+func (b0 MultiPoint_builder) Build() *MultiPoint {
+	m0 := &MultiPoint{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Points = &b.Points
+	return m0
+}
+
+// Represents a route, identified as a line string by a field option
+type Route struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Waypoints   *[]*latlng.LatLng      `protobuf:"bytes,2,rep,name=waypoints"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Route) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Route) GetWaypoints() []*latlng.LatLng {
+	if x != nil {
+		if x.xxx_hidden_Waypoints != nil {
+			return *x.xxx_hidden_Waypoints
+		}
+	}
+	return nil
+}
+
+func (x *Route) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *Route) SetWaypoints(v []*latlng.LatLng) {
+	x.xxx_hidden_Waypoints = &v
+}
+
+func (x *Route) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Route) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+type Route_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name      *string
+	Waypoints []*latlng.LatLng
+}
+
+func (b0 Route_builder) Build() *Route {
+	m0 := &Route{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Waypoints = &b.Waypoints
+	return m0
+}
+
+// Nested message for testing purposes
+type NestedMessage struct {
+	state                    protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_Text          *string                       `protobuf:"bytes,1,opt,name=text"`
+	xxx_hidden_Number        int32                         `protobuf:"varint,2,opt,name=number"`
+	xxx_hidden_Flag          bool                          `protobuf:"varint,3,opt,name=flag"`
+	xxx_hidden_Tags          []string                      `protobuf:"bytes,4,rep,name=tags"`
+	xxx_hidden_OptionalValue isNestedMessage_OptionalValue `protobuf_oneof:"optional_value"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *NestedMessage) Reset() {
+	*x = NestedMessage{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NestedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedMessage) ProtoMessage() {}
+
+func (x *NestedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NestedMessage) GetText() string {
+	if x != nil {
+		if x.xxx_hidden_Text != nil {
+			return *x.xxx_hidden_Text
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *NestedMessage) GetNumber() int32 {
+	if x != nil {
+		return x.xxx_hidden_Number
+	}
+	return 0
+}
+
+func (x *NestedMessage) GetFlag() bool {
+	if x != nil {
+		return x.xxx_hidden_Flag
+	}
+	return false
+}
+
+func (x *NestedMessage) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *NestedMessage) GetStringOption() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption); ok {
+			return x.StringOption
+		}
+	}
+	return ""
+}
+
+func (x *NestedMessage) GetIntOption() int64 {
+	if x != nil {
+		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption); ok {
+			return x.IntOption
+		}
+	}
+	return 0
+}
+
+func (x *NestedMessage) GetBoolOption() bool {
+	if x != nil {
+		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption); ok {
+			return x.BoolOption
+		}
+	}
+	return false
+}
+
+func (x *NestedMessage) GetTimestampOption() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_TimestampOption); ok {
+			return x.TimestampOption
+		}
+	}
+	return nil
+}
+
+func (x *NestedMessage) GetComplexOption() *NestedMessage_ComplexValue {
+	if x != nil {
+		if x, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption); ok {
+			return x.ComplexOption
+		}
+	}
+	return nil
+}
+
+func (x *NestedMessage) SetText(v string) {
+	x.xxx_hidden_Text = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *NestedMessage) SetNumber(v int32) {
+	x.xxx_hidden_Number = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *NestedMessage) SetFlag(v bool) {
+	x.xxx_hidden_Flag = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *NestedMessage) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *NestedMessage) SetStringOption(v string) {
+	x.xxx_hidden_OptionalValue = &nestedMessage_StringOption{v}
+}
+
+func (x *NestedMessage) SetIntOption(v int64) {
+	x.xxx_hidden_OptionalValue = &nestedMessage_IntOption{v}
+}
+
+func (x *NestedMessage) SetBoolOption(v bool) {
+	x.xxx_hidden_OptionalValue = &nestedMessage_BoolOption{v}
+}
+
+func (x *NestedMessage) SetTimestampOption(v *timestamppb.Timestamp) {
+	if v == nil {
+		x.xxx_hidden_OptionalValue = nil
+		return
+	}
+	x.xxx_hidden_OptionalValue = &nestedMessage_TimestampOption{v}
+}
+
+func (x *NestedMessage) SetComplexOption(v *NestedMessage_ComplexValue) {
+	if v == nil {
+		x.xxx_hidden_OptionalValue = nil
+		return
+	}
+	x.xxx_hidden_OptionalValue = &nestedMessage_ComplexOption{v}
+}
+
+func (x *NestedMessage) HasText() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *NestedMessage) HasNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *NestedMessage) HasFlag() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *NestedMessage) HasOptionalValue() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_OptionalValue != nil
+}
+
+func (x *NestedMessage) HasStringOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption)
+	return ok
+}
+
+func (x *NestedMessage) HasIntOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption)
+	return ok
+}
+
+func (x *NestedMessage) HasBoolOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption)
+	return ok
+}
+
+func (x *NestedMessage) HasTimestampOption() bool {
+	if x == nil {
+		return false
+	}
 	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_TimestampOption)
 	return ok
 }
 
-func (x *NestedMessage) HasComplexOption() bool {
+func (x *NestedMessage) HasComplexOption() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption)
+	return ok
+}
+
+func (x *NestedMessage) ClearText() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Text = nil
+}
+
+func (x *NestedMessage) ClearNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Number = 0
+}
+
+func (x *NestedMessage) ClearFlag() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Flag = false
+}
+
+func (x *NestedMessage) ClearOptionalValue() {
+	x.xxx_hidden_OptionalValue = nil
+}
+
+func (x *NestedMessage) ClearStringOption() {
+	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption); ok {
+		x.xxx_hidden_OptionalValue = nil
+	}
+}
+
+func (x *NestedMessage) ClearIntOption() {
+	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption); ok {
+		x.xxx_hidden_OptionalValue = nil
+	}
+}
+
+func (x *NestedMessage) ClearBoolOption() {
+	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption); ok {
+		x.xxx_hidden_OptionalValue = nil
+	}
+}
+
+func (x *NestedMessage) ClearTimestampOption() {
+	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_TimestampOption); ok {
+		x.xxx_hidden_OptionalValue = nil
+	}
+}
+
+func (x *NestedMessage) ClearComplexOption() {
+	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption); ok {
+		x.xxx_hidden_OptionalValue = nil
+	}
+}
+
+const NestedMessage_OptionalValue_not_set_case case_NestedMessage_OptionalValue = 0
+const NestedMessage_StringOption_case case_NestedMessage_OptionalValue = 5
+const NestedMessage_IntOption_case case_NestedMessage_OptionalValue = 6
+const NestedMessage_BoolOption_case case_NestedMessage_OptionalValue = 7
+const NestedMessage_TimestampOption_case case_NestedMessage_OptionalValue = 8
+const NestedMessage_ComplexOption_case case_NestedMessage_OptionalValue = 9
+
+func (x *NestedMessage) WhichOptionalValue() case_NestedMessage_OptionalValue {
+	if x == nil {
+		return NestedMessage_OptionalValue_not_set_case
+	}
+	switch x.xxx_hidden_OptionalValue.(type) {
+	case *nestedMessage_StringOption:
+		return NestedMessage_StringOption_case
+	case *nestedMessage_IntOption:
+		return NestedMessage_IntOption_case
+	case *nestedMessage_BoolOption:
+		return NestedMessage_BoolOption_case
+	case *nestedMessage_TimestampOption:
+		return NestedMessage_TimestampOption_case
+	case *nestedMessage_ComplexOption:
+		return NestedMessage_ComplexOption_case
+	default:
+		return NestedMessage_OptionalValue_not_set_case
+	}
+}
+
+type NestedMessage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Text   *string
+	Number *int32
+	Flag   *bool
+	Tags   []string
+	// Oneof field to test empty oneof scenarios in maps
+
+	// Fields of oneof xxx_hidden_OptionalValue:
+	StringOption    *string
+	IntOption       *int64
+	BoolOption      *bool
+	TimestampOption *timestamppb.Timestamp
+	ComplexOption   *NestedMessage_ComplexValue
+	// -- end of xxx_hidden_OptionalValue
+}
+
+func (b0 NestedMessage_builder) Build() *NestedMessage {
+	m0 := &NestedMessage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Text != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Text = b.Text
+	}
+	if b.Number != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Number = *b.Number
+	}
+	if b.Flag != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Flag = *b.Flag
+	}
+	x.xxx_hidden_Tags = b.Tags
+	if b.StringOption != nil {
+		x.xxx_hidden_OptionalValue = &nestedMessage_StringOption{*b.StringOption}
+	}
+	if b.IntOption != nil {
+		x.xxx_hidden_OptionalValue = &nestedMessage_IntOption{*b.IntOption}
+	}
+	if b.BoolOption != nil {
+		x.xxx_hidden_OptionalValue = &nestedMessage_BoolOption{*b.BoolOption}
+	}
+	if b.TimestampOption != nil {
+		x.xxx_hidden_OptionalValue = &nestedMessage_TimestampOption{b.TimestampOption}
+	}
+	if b.ComplexOption != nil {
+		x.xxx_hidden_OptionalValue = &nestedMessage_ComplexOption{b.ComplexOption}
+	}
+	return m0
+}
+
+type case_NestedMessage_OptionalValue protoreflect.FieldNumber
+
+func (x case_NestedMessage_OptionalValue) String() string {
+	md := file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[11].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isNestedMessage_OptionalValue interface {
+	isNestedMessage_OptionalValue()
+}
+
+type nestedMessage_StringOption struct {
+	StringOption string `protobuf:"bytes,5,opt,name=string_option,json=stringOption,oneof"`
+}
+
+type nestedMessage_IntOption struct {
+	IntOption int64 `protobuf:"varint,6,opt,name=int_option,json=intOption,oneof"`
+}
+
+type nestedMessage_BoolOption struct {
+	BoolOption bool `protobuf:"varint,7,opt,name=bool_option,json=boolOption,oneof"`
+}
+
+type nestedMessage_TimestampOption struct {
+	TimestampOption *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp_option,json=timestampOption,oneof"`
+}
+
+type nestedMessage_ComplexOption struct {
+	ComplexOption *NestedMessage_ComplexValue `protobuf:"bytes,9,opt,name=complex_option,json=complexOption,oneof"`
+}
+
+func (*nestedMessage_StringOption) isNestedMessage_OptionalValue() {}
+
+func (*nestedMessage_IntOption) isNestedMessage_OptionalValue() {}
+
+func (*nestedMessage_BoolOption) isNestedMessage_OptionalValue() {}
+
+func (*nestedMessage_TimestampOption) isNestedMessage_OptionalValue() {}
+
+func (*nestedMessage_ComplexOption) isNestedMessage_OptionalValue() {}
+
+// Message with renamed columns for testing field name mapping.
+type RenamedFields struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DisplayName *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName"`
+	xxx_hidden_CreatedBy   *string                `protobuf:"bytes,2,opt,name=created_by,json=author"`
+	xxx_hidden_UserId      int64                  `protobuf:"varint,3,opt,name=user_id,json=userId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RenamedFields) Reset() {
+	*x = RenamedFields{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamedFields) ProtoMessage() {}
+
+func (x *RenamedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RenamedFields) GetDisplayName() string {
+	if x != nil {
+		if x.xxx_hidden_DisplayName != nil {
+			return *x.xxx_hidden_DisplayName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RenamedFields) GetCreatedBy() string {
+	if x != nil {
+		if x.xxx_hidden_CreatedBy != nil {
+			return *x.xxx_hidden_CreatedBy
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RenamedFields) GetUserId() int64 {
+	if x != nil {
+		return x.xxx_hidden_UserId
+	}
+	return 0
+}
+
+func (x *RenamedFields) SetDisplayName(v string) {
+	x.xxx_hidden_DisplayName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *RenamedFields) SetCreatedBy(v string) {
+	x.xxx_hidden_CreatedBy = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *RenamedFields) SetUserId(v int64) {
+	x.xxx_hidden_UserId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *RenamedFields) HasDisplayName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RenamedFields) HasCreatedBy() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RenamedFields) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *RenamedFields) ClearDisplayName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DisplayName = nil
+}
+
+func (x *RenamedFields) ClearCreatedBy() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CreatedBy = nil
+}
+
+func (x *RenamedFields) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UserId = 0
+}
+
+type RenamedFields_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DisplayName *string
+	CreatedBy   *string
+	UserId      *int64
+}
+
+func (b0 RenamedFields_builder) Build() *RenamedFields {
+	m0 := &RenamedFields{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DisplayName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_DisplayName = b.DisplayName
+	}
+	if b.CreatedBy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_CreatedBy = b.CreatedBy
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_UserId = *b.UserId
+	}
+	return m0
+}
+
+// Message with fields of each presence for testing NULL handling.
+type FieldPresence struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,1,req,name=id"`
+	xxx_hidden_Count          int64                  `protobuf:"varint,2,opt,name=count"`
+	xxx_hidden_ImplicitCount  int64                  `protobuf:"varint,3,opt,name=implicit_count,json=implicitCount"`
+	xxx_hidden_Nested         *NestedMessage         `protobuf:"bytes,4,opt,name=nested"`
+	xxx_hidden_RequiredNested *NestedMessage         `protobuf:"bytes,5,req,name=required_nested,json=requiredNested"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *FieldPresence) Reset() {
+	*x = FieldPresence{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPresence) ProtoMessage() {}
+
+func (x *FieldPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FieldPresence) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FieldPresence) GetCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *FieldPresence) GetImplicitCount() int64 {
+	if x != nil {
+		return x.xxx_hidden_ImplicitCount
+	}
+	return 0
+}
+
+func (x *FieldPresence) GetNested() *NestedMessage {
+	if x != nil {
+		return x.xxx_hidden_Nested
+	}
+	return nil
+}
+
+func (x *FieldPresence) GetRequiredNested() *NestedMessage {
+	if x != nil {
+		return x.xxx_hidden_RequiredNested
+	}
+	return nil
+}
+
+func (x *FieldPresence) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *FieldPresence) SetCount(v int64) {
+	x.xxx_hidden_Count = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *FieldPresence) SetImplicitCount(v int64) {
+	x.xxx_hidden_ImplicitCount = v
+}
+
+func (x *FieldPresence) SetNested(v *NestedMessage) {
+	x.xxx_hidden_Nested = v
+}
+
+func (x *FieldPresence) SetRequiredNested(v *NestedMessage) {
+	x.xxx_hidden_RequiredNested = v
+}
+
+func (x *FieldPresence) HasId() bool {
 	if x == nil {
 		return false
 	}
-	_, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption)
-	return ok
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *NestedMessage) ClearText() {
+func (x *FieldPresence) HasCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FieldPresence) HasNested() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Nested != nil
+}
+
+func (x *FieldPresence) HasRequiredNested() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RequiredNested != nil
+}
+
+func (x *FieldPresence) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Text = nil
+	x.xxx_hidden_Id = nil
 }
 
-func (x *NestedMessage) ClearNumber() {
+func (x *FieldPresence) ClearCount() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Number = 0
+	x.xxx_hidden_Count = 0
 }
 
-func (x *NestedMessage) ClearFlag() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Flag = false
+func (x *FieldPresence) ClearNested() {
+	x.xxx_hidden_Nested = nil
 }
 
-func (x *NestedMessage) ClearOptionalValue() {
-	x.xxx_hidden_OptionalValue = nil
+func (x *FieldPresence) ClearRequiredNested() {
+	x.xxx_hidden_RequiredNested = nil
 }
 
-func (x *NestedMessage) ClearStringOption() {
-	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_StringOption); ok {
-		x.xxx_hidden_OptionalValue = nil
+type FieldPresence_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id             *string
+	Count          *int64
+	ImplicitCount  int64
+	Nested         *NestedMessage
+	RequiredNested *NestedMessage
+}
+
+func (b0 FieldPresence_builder) Build() *FieldPresence {
+	m0 := &FieldPresence{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Count != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Count = *b.Count
 	}
+	x.xxx_hidden_ImplicitCount = b.ImplicitCount
+	x.xxx_hidden_Nested = b.Nested
+	x.xxx_hidden_RequiredNested = b.RequiredNested
+	return m0
 }
 
-func (x *NestedMessage) ClearIntOption() {
-	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_IntOption); ok {
-		x.xxx_hidden_OptionalValue = nil
+// Message storing unknown columns in a google.protobuf.Struct field.
+type UnknownColumnsStruct struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Extra       *structpb.Struct       `protobuf:"bytes,2,opt,name=extra"`
+	xxx_hidden_Nested      *UnknownColumnsMap     `protobuf:"bytes,3,opt,name=nested"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UnknownColumnsStruct) Reset() {
+	*x = UnknownColumnsStruct{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnknownColumnsStruct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnknownColumnsStruct) ProtoMessage() {}
+
+func (x *UnknownColumnsStruct) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *NestedMessage) ClearBoolOption() {
-	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_BoolOption); ok {
-		x.xxx_hidden_OptionalValue = nil
+func (x *UnknownColumnsStruct) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
 	}
+	return ""
 }
 
-func (x *NestedMessage) ClearTimestampOption() {
-	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_TimestampOption); ok {
-		x.xxx_hidden_OptionalValue = nil
+func (x *UnknownColumnsStruct) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.xxx_hidden_Extra
 	}
+	return nil
 }
 
-func (x *NestedMessage) ClearComplexOption() {
-	if _, ok := x.xxx_hidden_OptionalValue.(*nestedMessage_ComplexOption); ok {
-		x.xxx_hidden_OptionalValue = nil
+func (x *UnknownColumnsStruct) GetNested() *UnknownColumnsMap {
+	if x != nil {
+		return x.xxx_hidden_Nested
 	}
+	return nil
 }
 
-const NestedMessage_OptionalValue_not_set_case case_NestedMessage_OptionalValue = 0
-const NestedMessage_StringOption_case case_NestedMessage_OptionalValue = 5
-const NestedMessage_IntOption_case case_NestedMessage_OptionalValue = 6
-const NestedMessage_BoolOption_case case_NestedMessage_OptionalValue = 7
-const NestedMessage_TimestampOption_case case_NestedMessage_OptionalValue = 8
-const NestedMessage_ComplexOption_case case_NestedMessage_OptionalValue = 9
+func (x *UnknownColumnsStruct) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
 
-func (x *NestedMessage) WhichOptionalValue() case_NestedMessage_OptionalValue {
+func (x *UnknownColumnsStruct) SetExtra(v *structpb.Struct) {
+	x.xxx_hidden_Extra = v
+}
+
+func (x *UnknownColumnsStruct) SetNested(v *UnknownColumnsMap) {
+	x.xxx_hidden_Nested = v
+}
+
+func (x *UnknownColumnsStruct) HasId() bool {
 	if x == nil {
-		return NestedMessage_OptionalValue_not_set_case
+		return false
 	}
-	switch x.xxx_hidden_OptionalValue.(type) {
-	case *nestedMessage_StringOption:
-		return NestedMessage_StringOption_case
-	case *nestedMessage_IntOption:
-		return NestedMessage_IntOption_case
-	case *nestedMessage_BoolOption:
-		return NestedMessage_BoolOption_case
-	case *nestedMessage_TimestampOption:
-		return NestedMessage_TimestampOption_case
-	case *nestedMessage_ComplexOption:
-		return NestedMessage_ComplexOption_case
-	default:
-		return NestedMessage_OptionalValue_not_set_case
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UnknownColumnsStruct) HasExtra() bool {
+	if x == nil {
+		return false
 	}
+	return x.xxx_hidden_Extra != nil
 }
 
-type NestedMessage_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *UnknownColumnsStruct) HasNested() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Nested != nil
+}
 
-	Text   *string
-	Number *int32
-	Flag   *bool
-	Tags   []string
-	// Oneof field to test empty oneof scenarios in maps
+func (x *UnknownColumnsStruct) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
 
-	// Fields of oneof xxx_hidden_OptionalValue:
-	StringOption    *string
-	IntOption       *int64
-	BoolOption      *bool
-	TimestampOption *timestamppb.Timestamp
-	ComplexOption   *NestedMessage_ComplexValue
-	// -- end of xxx_hidden_OptionalValue
+func (x *UnknownColumnsStruct) ClearExtra() {
+	x.xxx_hidden_Extra = nil
 }
 
-func (b0 NestedMessage_builder) Build() *NestedMessage {
-	m0 := &NestedMessage{}
+func (x *UnknownColumnsStruct) ClearNested() {
+	x.xxx_hidden_Nested = nil
+}
+
+type UnknownColumnsStruct_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id     *string
+	Extra  *structpb.Struct
+	Nested *UnknownColumnsMap
+}
+
+func (b0 UnknownColumnsStruct_builder) Build() *UnknownColumnsStruct {
+	m0 := &UnknownColumnsStruct{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Text != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
-		x.xxx_hidden_Text = b.Text
-	}
-	if b.Number != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
-		x.xxx_hidden_Number = *b.Number
-	}
-	if b.Flag != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
-		x.xxx_hidden_Flag = *b.Flag
-	}
-	x.xxx_hidden_Tags = b.Tags
-	if b.StringOption != nil {
-		x.xxx_hidden_OptionalValue = &nestedMessage_StringOption{*b.StringOption}
-	}
-	if b.IntOption != nil {
-		x.xxx_hidden_OptionalValue = &nestedMessage_IntOption{*b.IntOption}
-	}
-	if b.BoolOption != nil {
-		x.xxx_hidden_OptionalValue = &nestedMessage_BoolOption{*b.BoolOption}
-	}
-	if b.TimestampOption != nil {
-		x.xxx_hidden_OptionalValue = &nestedMessage_TimestampOption{b.TimestampOption}
-	}
-	if b.ComplexOption != nil {
-		x.xxx_hidden_OptionalValue = &nestedMessage_ComplexOption{b.ComplexOption}
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
 	}
+	x.xxx_hidden_Extra = b.Extra
+	x.xxx_hidden_Nested = b.Nested
 	return m0
 }
 
-type case_NestedMessage_OptionalValue protoreflect.FieldNumber
+// Message storing unknown columns in a map<string, string> field.
+type UnknownColumnsMap struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Extra       map[string]string      `protobuf:"bytes,2,rep,name=extra" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
 
-func (x case_NestedMessage_OptionalValue) String() string {
-	md := file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[4].Descriptor()
-	if x == 0 {
-		return "not set"
-	}
-	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+func (x *UnknownColumnsMap) Reset() {
+	*x = UnknownColumnsMap{}
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isNestedMessage_OptionalValue interface {
-	isNestedMessage_OptionalValue()
+func (x *UnknownColumnsMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type nestedMessage_StringOption struct {
-	StringOption string `protobuf:"bytes,5,opt,name=string_option,json=stringOption,oneof"`
+func (*UnknownColumnsMap) ProtoMessage() {}
+
+func (x *UnknownColumnsMap) ProtoReflect() protoreflect.Message {
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type nestedMessage_IntOption struct {
-	IntOption int64 `protobuf:"varint,6,opt,name=int_option,json=intOption,oneof"`
+func (x *UnknownColumnsMap) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
 }
 
-type nestedMessage_BoolOption struct {
-	BoolOption bool `protobuf:"varint,7,opt,name=bool_option,json=boolOption,oneof"`
+func (x *UnknownColumnsMap) GetExtra() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Extra
+	}
+	return nil
 }
 
-type nestedMessage_TimestampOption struct {
-	TimestampOption *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp_option,json=timestampOption,oneof"`
+func (x *UnknownColumnsMap) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-type nestedMessage_ComplexOption struct {
-	ComplexOption *NestedMessage_ComplexValue `protobuf:"bytes,9,opt,name=complex_option,json=complexOption,oneof"`
+func (x *UnknownColumnsMap) SetExtra(v map[string]string) {
+	x.xxx_hidden_Extra = v
 }
 
-func (*nestedMessage_StringOption) isNestedMessage_OptionalValue() {}
+func (x *UnknownColumnsMap) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
 
-func (*nestedMessage_IntOption) isNestedMessage_OptionalValue() {}
+func (x *UnknownColumnsMap) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
 
-func (*nestedMessage_BoolOption) isNestedMessage_OptionalValue() {}
+type UnknownColumnsMap_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-func (*nestedMessage_TimestampOption) isNestedMessage_OptionalValue() {}
+	Id    *string
+	Extra map[string]string
+}
 
-func (*nestedMessage_ComplexOption) isNestedMessage_OptionalValue() {}
+func (b0 UnknownColumnsMap_builder) Build() *UnknownColumnsMap {
+	m0 := &UnknownColumnsMap{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	x.xxx_hidden_Extra = b.Extra
+	return m0
+}
 
 // Complex nested message for testing map scenarios
 type NestedMessage_ComplexValue struct {
@@ -2319,7 +4181,7 @@ type NestedMessage_ComplexValue struct {
 
 func (x *NestedMessage_ComplexValue) Reset() {
 	*x = NestedMessage_ComplexValue{}
-	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[18]
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2331,7 +4193,7 @@ func (x *NestedMessage_ComplexValue) String() string {
 func (*NestedMessage_ComplexValue) ProtoMessage() {}
 
 func (x *NestedMessage_ComplexValue) ProtoReflect() protoreflect.Message {
-	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[18]
+	mi := &file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +4289,7 @@ var File_wayplatform_testdata_v1_kitchen_sink_proto protoreflect.FileDescriptor
 
 const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\n" +
-	"*wayplatform/testdata/v1/kitchen_sink.proto\x12\x17wayplatform.testdata.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x16google/type/date.proto\x1a\x1agoogle/type/datetime.proto\x1a\x18google/type/latlng.proto\x1a\x1bgoogle/type/timeofday.proto\"\xee+\n" +
+	"*wayplatform/testdata/v1/kitchen_sink.proto\x12\x17wayplatform.testdata.v1\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x16google/type/date.proto\x1a\x1agoogle/type/datetime.proto\x1a\x19google/type/decimal.proto\x1a\x1agoogle/type/fraction.proto\x1a\x18google/type/latlng.proto\x1a\x17google/type/money.proto\x1a\x1bgoogle/type/timeofday.proto\x1a\x1cprotobq/v1/annotations.proto\"\xa1;\n" +
 	"\vKitchenSink\x12!\n" +
 	"\fdouble_value\x18\x01 \x01(\x01R\vdoubleValue\x12\x1f\n" +
 	"\vfloat_value\x18\x02 \x01(\x02R\n" +
@@ -2501,7 +4363,39 @@ const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\x15map_string_date_range\x187 \x03(\v2<.wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntryR\x12mapStringDateRange\x12_\n" +
 	"\x0fmap_string_date\x18> \x03(\v27.wayplatform.testdata.v1.KitchenSink.MapStringDateEntryR\rmapStringDate\x12k\n" +
 	"\x13map_string_datetime\x18? \x03(\v2;.wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntryR\x11mapStringDatetime\x12n\n" +
-	"\x14map_string_timeofday\x18@ \x03(\v2<.wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntryR\x12mapStringTimeofday\x1aB\n" +
+	"\x14map_string_timeofday\x18@ \x03(\v2<.wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntryR\x12mapStringTimeofday\x121\n" +
+	"\tany_value\x18A \x01(\v2\x14.google.protobuf.AnyR\banyValue\x127\n" +
+	"\frepeated_any\x18B \x03(\v2\x14.google.protobuf.AnyR\vrepeatedAny\x125\n" +
+	"\n" +
+	"json_value\x18C \x01(\v2\x16.google.protobuf.ValueR\tjsonValue\x129\n" +
+	"\n" +
+	"list_value\x18D \x01(\v2\x1a.google.protobuf.ListValueR\tlistValue\x12:\n" +
+	"\fstruct_value\x18E \x01(\v2\x17.google.protobuf.StructR\vstructValue\x129\n" +
+	"\n" +
+	"null_value\x18F \x01(\x0e2\x1a.google.protobuf.NullValueR\tnullValue\x12=\n" +
+	"\x0erepeated_value\x18G \x03(\v2\x16.google.protobuf.ValueR\rrepeatedValue\x12b\n" +
+	"\x10map_string_value\x18H \x03(\v28.wayplatform.testdata.v1.KitchenSink.MapStringValueEntryR\x0emapStringValue\x129\n" +
+	"\rdecimal_value\x18I \x01(\v2\x14.google.type.DecimalR\fdecimalValue\x12<\n" +
+	"\x0efraction_value\x18J \x01(\v2\x15.google.type.FractionR\rfractionValue\x12>\n" +
+	"\vmoney_value\x18K \x01(\v2\x12.google.type.MoneyB\t\x92\x82\x19\x05\x12\x03EURR\n" +
+	"moneyValue\x12>\n" +
+	"\x05price\x18L \x01(\v2\x12.google.type.MoneyB\x14\x92\x82\x19\x10\x1a\x0eprice_currencyR\x05price\x12?\n" +
+	"\x10repeated_decimal\x18M \x03(\v2\x14.google.type.DecimalR\x0frepeatedDecimal\x12h\n" +
+	"\x12map_string_decimal\x18N \x03(\v2:.wayplatform.testdata.v1.KitchenSink.MapStringDecimalEntryR\x10mapStringDecimal\x12H\n" +
+	"\x0einterval_value\x18O \x01(\v2!.wayplatform.testdata.v1.IntervalR\rintervalValue\x12N\n" +
+	"\x0fmonth_day_nanos\x18P \x01(\v2&.wayplatform.testdata.v1.MonthDayNanosR\rmonthDayNanos\x12N\n" +
+	"\x11repeated_interval\x18Q \x03(\v2!.wayplatform.testdata.v1.IntervalR\x10repeatedInterval\x12D\n" +
+	"\vline_string\x18R \x01(\v2#.wayplatform.testdata.v1.LineStringR\n" +
+	"lineString\x12:\n" +
+	"\apolygon\x18S \x01(\v2 .wayplatform.testdata.v1.PolygonR\apolygon\x12D\n" +
+	"\vmulti_point\x18T \x01(\v2#.wayplatform.testdata.v1.MultiPointR\n" +
+	"multiPoint\x12<\n" +
+	"\x05route\x18U \x01(\v2\x1e.wayplatform.testdata.v1.RouteB\x06\x92\x82\x19\x02 \x01R\x05route\x12K\n" +
+	"\x10repeated_polygon\x18V \x03(\v2 .wayplatform.testdata.v1.PolygonR\x0frepeatedPolygon\x12M\n" +
+	"\x10timestamp_millis\x18W \x01(\v2\x1a.google.protobuf.TimestampB\x06\x92\x82\x19\x02(\x02R\x0ftimestampMillis\x12J\n" +
+	"\x0fduration_millis\x18X \x01(\v2\x19.google.protobuf.DurationB\x06\x92\x82\x19\x02(\x02R\x0edurationMillis\x12)\n" +
+	"\funix_seconds\x18Y \x01(\x03B\x06\x92\x82\x19\x02(\x01R\vunixSeconds\x12\\\n" +
+	"\x18repeated_timestamp_nanos\x18Z \x03(\v2\x1a.google.protobuf.TimestampB\x06\x92\x82\x19\x02(\x04R\x16repeatedTimestampNanos\x1aB\n" +
 	"\x14MapStringStringEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
@@ -2540,7 +4434,13 @@ const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x15.google.type.DateTimeR\x05value:\x028\x01\x1a]\n" +
 	"\x17MapStringTimeofdayEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.google.type.TimeOfDayR\x05value:\x028\x01\"3\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.type.TimeOfDayR\x05value:\x028\x01\x1aY\n" +
+	"\x13MapStringValueEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value:\x028\x01\x1aY\n" +
+	"\x15MapStringDecimalEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.type.DecimalR\x05value:\x028\x01\"3\n" +
 	"\tDateRange\x12\x14\n" +
 	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
 	"\x03end\x18\x02 \x01(\tR\x03end\"p\n" +
@@ -2549,7 +4449,33 @@ const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"7\n" +
 	"\rDateTimeRange\x12\x14\n" +
 	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
-	"\x03end\x18\x02 \x01(\tR\x03end\"\x85\x04\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\xac\x01\n" +
+	"\bInterval\x12\x14\n" +
+	"\x05years\x18\x01 \x01(\x05R\x05years\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05hours\x18\x04 \x01(\x05R\x05hours\x12\x18\n" +
+	"\aminutes\x18\x05 \x01(\x05R\aminutes\x12\x18\n" +
+	"\aseconds\x18\x06 \x01(\x05R\aseconds\x12\x14\n" +
+	"\x05nanos\x18\a \x01(\x05R\x05nanos\"Q\n" +
+	"\rMonthDayNanos\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x03R\x05nanos\"9\n" +
+	"\n" +
+	"LineString\x12+\n" +
+	"\x06points\x18\x01 \x03(\v2\x13.google.type.LatLngR\x06points\"D\n" +
+	"\aPolygon\x129\n" +
+	"\x05rings\x18\x01 \x03(\v2#.wayplatform.testdata.v1.LinearRingR\x05rings\"9\n" +
+	"\n" +
+	"LinearRing\x12+\n" +
+	"\x06points\x18\x01 \x03(\v2\x13.google.type.LatLngR\x06points\"9\n" +
+	"\n" +
+	"MultiPoint\x12+\n" +
+	"\x06points\x18\x01 \x03(\v2\x13.google.type.LatLngR\x06points\"N\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\twaypoints\x18\x02 \x03(\v2\x13.google.type.LatLngR\twaypoints\"\x85\x04\n" +
 	"\rNestedMessage\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x12\n" +
@@ -2566,7 +4492,30 @@ const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\tdevice_id\x18\x01 \x01(\tR\bdeviceId\x12\x16\n" +
 	"\x06labels\x18\x02 \x03(\tR\x06labels\x127\n" +
 	"\tlast_seen\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeenB\x10\n" +
-	"\x0eoptional_value*W\n" +
+	"\x0eoptional_value\"r\n" +
+	"\rRenamedFields\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1a\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\x06author\x12\"\n" +
+	"\auser_id\x18\x03 \x01(\x03B\t\x92\x82\x19\x05\n" +
+	"\x03uidR\x06userId\"\x82\x02\n" +
+	"\rFieldPresence\x12\x15\n" +
+	"\x02id\x18\x01 \x01(\tB\x05\xaa\x01\x02\b\x03R\x02id\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12,\n" +
+	"\x0eimplicit_count\x18\x03 \x01(\x03B\x05\xaa\x01\x02\b\x02R\rimplicitCount\x12>\n" +
+	"\x06nested\x18\x04 \x01(\v2&.wayplatform.testdata.v1.NestedMessageR\x06nested\x12V\n" +
+	"\x0frequired_nested\x18\x05 \x01(\v2&.wayplatform.testdata.v1.NestedMessageB\x05\xaa\x01\x02\b\x03R\x0erequiredNested\"\xa1\x01\n" +
+	"\x14UnknownColumnsStruct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
+	"\x05extra\x18\x02 \x01(\v2\x17.google.protobuf.StructB\x06\x92\x82\x19\x020\x01R\x05extra\x12B\n" +
+	"\x06nested\x18\x03 \x01(\v2*.wayplatform.testdata.v1.UnknownColumnsMapR\x06nested\"\xb2\x01\n" +
+	"\x11UnknownColumnsMap\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12S\n" +
+	"\x05extra\x18\x02 \x03(\v25.wayplatform.testdata.v1.UnknownColumnsMap.ExtraEntryB\x06\x92\x82\x19\x020\x01R\x05extra\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*W\n" +
 	"\bTestEnum\x12\x19\n" +
 	"\x15TEST_ENUM_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TEST_ENUM_VALUE_ONE\x10\x01\x12\x17\n" +
@@ -2574,109 +4523,168 @@ const file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc = "" +
 	"\x1bcom.wayplatform.testdata.v1B\x10KitchenSinkProtoP\x01ZRgithub.com/way-platform/protobg-go/internal/gen/wayplatform/testdata/v1;testdatav1\xa2\x02\x03WTX\xaa\x02\x17Wayplatform.Testdata.V1\xca\x02\x17Wayplatform\\Testdata\\V1\xe2\x02#Wayplatform\\Testdata\\V1\\GPBMetadata\xea\x02\x19Wayplatform::Testdata::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_testdata_v1_kitchen_sink_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_wayplatform_testdata_v1_kitchen_sink_proto_goTypes = []any{
 	(TestEnum)(0),                      // 0: wayplatform.testdata.v1.TestEnum
 	(*KitchenSink)(nil),                // 1: wayplatform.testdata.v1.KitchenSink
 	(*DateRange)(nil),                  // 2: wayplatform.testdata.v1.DateRange
 	(*TimestampRange)(nil),             // 3: wayplatform.testdata.v1.TimestampRange
 	(*DateTimeRange)(nil),              // 4: wayplatform.testdata.v1.DateTimeRange
-	(*NestedMessage)(nil),              // 5: wayplatform.testdata.v1.NestedMessage
-	nil,                                // 6: wayplatform.testdata.v1.KitchenSink.MapStringStringEntry
-	nil,                                // 7: wayplatform.testdata.v1.KitchenSink.MapStringInt32Entry
-	nil,                                // 8: wayplatform.testdata.v1.KitchenSink.MapInt32StringEntry
-	nil,                                // 9: wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry
-	nil,                                // 10: wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry
-	nil,                                // 11: wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry
-	nil,                                // 12: wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry
-	nil,                                // 13: wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry
-	nil,                                // 14: wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry
-	nil,                                // 15: wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry
-	nil,                                // 16: wayplatform.testdata.v1.KitchenSink.MapStringDateEntry
-	nil,                                // 17: wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry
-	nil,                                // 18: wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry
-	(*NestedMessage_ComplexValue)(nil), // 19: wayplatform.testdata.v1.NestedMessage.ComplexValue
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),        // 21: google.protobuf.Duration
-	(*date.Date)(nil),                  // 22: google.type.Date
-	(*datetime.DateTime)(nil),          // 23: google.type.DateTime
-	(*timeofday.TimeOfDay)(nil),        // 24: google.type.TimeOfDay
-	(*wrapperspb.StringValue)(nil),     // 25: google.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),      // 26: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),      // 27: google.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil),     // 28: google.protobuf.UInt32Value
-	(*wrapperspb.UInt64Value)(nil),     // 29: google.protobuf.UInt64Value
-	(*wrapperspb.BoolValue)(nil),       // 30: google.protobuf.BoolValue
-	(*wrapperspb.FloatValue)(nil),      // 31: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil),     // 32: google.protobuf.DoubleValue
-	(*wrapperspb.BytesValue)(nil),      // 33: google.protobuf.BytesValue
-	(*latlng.LatLng)(nil),              // 34: google.type.LatLng
+	(*Interval)(nil),                   // 5: wayplatform.testdata.v1.Interval
+	(*MonthDayNanos)(nil),              // 6: wayplatform.testdata.v1.MonthDayNanos
+	(*LineString)(nil),                 // 7: wayplatform.testdata.v1.LineString
+	(*Polygon)(nil),                    // 8: wayplatform.testdata.v1.Polygon
+	(*LinearRing)(nil),                 // 9: wayplatform.testdata.v1.LinearRing
+	(*MultiPoint)(nil),                 // 10: wayplatform.testdata.v1.MultiPoint
+	(*Route)(nil),                      // 11: wayplatform.testdata.v1.Route
+	(*NestedMessage)(nil),              // 12: wayplatform.testdata.v1.NestedMessage
+	(*RenamedFields)(nil),              // 13: wayplatform.testdata.v1.RenamedFields
+	(*FieldPresence)(nil),              // 14: wayplatform.testdata.v1.FieldPresence
+	(*UnknownColumnsStruct)(nil),       // 15: wayplatform.testdata.v1.UnknownColumnsStruct
+	(*UnknownColumnsMap)(nil),          // 16: wayplatform.testdata.v1.UnknownColumnsMap
+	nil,                                // 17: wayplatform.testdata.v1.KitchenSink.MapStringStringEntry
+	nil,                                // 18: wayplatform.testdata.v1.KitchenSink.MapStringInt32Entry
+	nil,                                // 19: wayplatform.testdata.v1.KitchenSink.MapInt32StringEntry
+	nil,                                // 20: wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry
+	nil,                                // 21: wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry
+	nil,                                // 22: wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry
+	nil,                                // 23: wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry
+	nil,                                // 24: wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry
+	nil,                                // 25: wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry
+	nil,                                // 26: wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry
+	nil,                                // 27: wayplatform.testdata.v1.KitchenSink.MapStringDateEntry
+	nil,                                // 28: wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry
+	nil,                                // 29: wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry
+	nil,                                // 30: wayplatform.testdata.v1.KitchenSink.MapStringValueEntry
+	nil,                                // 31: wayplatform.testdata.v1.KitchenSink.MapStringDecimalEntry
+	(*NestedMessage_ComplexValue)(nil), // 32: wayplatform.testdata.v1.NestedMessage.ComplexValue
+	nil,                                // 33: wayplatform.testdata.v1.UnknownColumnsMap.ExtraEntry
+	(*timestamppb.Timestamp)(nil),      // 34: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 35: google.protobuf.Duration
+	(*date.Date)(nil),                  // 36: google.type.Date
+	(*datetime.DateTime)(nil),          // 37: google.type.DateTime
+	(*timeofday.TimeOfDay)(nil),        // 38: google.type.TimeOfDay
+	(*wrapperspb.StringValue)(nil),     // 39: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),      // 40: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),      // 41: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),     // 42: google.protobuf.UInt32Value
+	(*wrapperspb.UInt64Value)(nil),     // 43: google.protobuf.UInt64Value
+	(*wrapperspb.BoolValue)(nil),       // 44: google.protobuf.BoolValue
+	(*wrapperspb.FloatValue)(nil),      // 45: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),     // 46: google.protobuf.DoubleValue
+	(*wrapperspb.BytesValue)(nil),      // 47: google.protobuf.BytesValue
+	(*latlng.LatLng)(nil),              // 48: google.type.LatLng
+	(*anypb.Any)(nil),                  // 49: google.protobuf.Any
+	(*structpb.Value)(nil),             // 50: google.protobuf.Value
+	(*structpb.ListValue)(nil),         // 51: google.protobuf.ListValue
+	(*structpb.Struct)(nil),            // 52: google.protobuf.Struct
+	(structpb.NullValue)(0),            // 53: google.protobuf.NullValue
+	(*decimal.Decimal)(nil),            // 54: google.type.Decimal
+	(*fraction.Fraction)(nil),          // 55: google.type.Fraction
+	(*money.Money)(nil),                // 56: google.type.Money
 }
 var file_wayplatform_testdata_v1_kitchen_sink_proto_depIdxs = []int32{
 	0,  // 0: wayplatform.testdata.v1.KitchenSink.enum_value:type_name -> wayplatform.testdata.v1.TestEnum
-	5,  // 1: wayplatform.testdata.v1.KitchenSink.repeated_nested:type_name -> wayplatform.testdata.v1.NestedMessage
-	5,  // 2: wayplatform.testdata.v1.KitchenSink.nested_message:type_name -> wayplatform.testdata.v1.NestedMessage
-	6,  // 3: wayplatform.testdata.v1.KitchenSink.map_string_string:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringStringEntry
-	7,  // 4: wayplatform.testdata.v1.KitchenSink.map_string_int32:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringInt32Entry
-	8,  // 5: wayplatform.testdata.v1.KitchenSink.map_int32_string:type_name -> wayplatform.testdata.v1.KitchenSink.MapInt32StringEntry
-	9,  // 6: wayplatform.testdata.v1.KitchenSink.map_string_nested:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry
-	20, // 7: wayplatform.testdata.v1.KitchenSink.timestamp_value:type_name -> google.protobuf.Timestamp
-	21, // 8: wayplatform.testdata.v1.KitchenSink.duration_value:type_name -> google.protobuf.Duration
-	22, // 9: wayplatform.testdata.v1.KitchenSink.date_value:type_name -> google.type.Date
-	23, // 10: wayplatform.testdata.v1.KitchenSink.datetime_value:type_name -> google.type.DateTime
-	24, // 11: wayplatform.testdata.v1.KitchenSink.timeofday_value:type_name -> google.type.TimeOfDay
-	25, // 12: wayplatform.testdata.v1.KitchenSink.string_wrapper_value:type_name -> google.protobuf.StringValue
-	26, // 13: wayplatform.testdata.v1.KitchenSink.int32_wrapper_value:type_name -> google.protobuf.Int32Value
-	27, // 14: wayplatform.testdata.v1.KitchenSink.int64_wrapper_value:type_name -> google.protobuf.Int64Value
-	28, // 15: wayplatform.testdata.v1.KitchenSink.uint32_wrapper_value:type_name -> google.protobuf.UInt32Value
-	29, // 16: wayplatform.testdata.v1.KitchenSink.uint64_wrapper_value:type_name -> google.protobuf.UInt64Value
-	30, // 17: wayplatform.testdata.v1.KitchenSink.bool_wrapper_value:type_name -> google.protobuf.BoolValue
-	31, // 18: wayplatform.testdata.v1.KitchenSink.float_wrapper_value:type_name -> google.protobuf.FloatValue
-	32, // 19: wayplatform.testdata.v1.KitchenSink.double_wrapper_value:type_name -> google.protobuf.DoubleValue
-	33, // 20: wayplatform.testdata.v1.KitchenSink.bytes_wrapper_value:type_name -> google.protobuf.BytesValue
-	25, // 21: wayplatform.testdata.v1.KitchenSink.repeated_string_wrapper:type_name -> google.protobuf.StringValue
-	26, // 22: wayplatform.testdata.v1.KitchenSink.repeated_int32_wrapper:type_name -> google.protobuf.Int32Value
-	20, // 23: wayplatform.testdata.v1.KitchenSink.repeated_timestamp:type_name -> google.protobuf.Timestamp
-	10, // 24: wayplatform.testdata.v1.KitchenSink.map_string_int32_wrapper:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry
-	11, // 25: wayplatform.testdata.v1.KitchenSink.map_string_string_wrapper:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry
-	12, // 26: wayplatform.testdata.v1.KitchenSink.map_string_timestamp:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry
-	34, // 27: wayplatform.testdata.v1.KitchenSink.latlng_value:type_name -> google.type.LatLng
+	12, // 1: wayplatform.testdata.v1.KitchenSink.repeated_nested:type_name -> wayplatform.testdata.v1.NestedMessage
+	12, // 2: wayplatform.testdata.v1.KitchenSink.nested_message:type_name -> wayplatform.testdata.v1.NestedMessage
+	17, // 3: wayplatform.testdata.v1.KitchenSink.map_string_string:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringStringEntry
+	18, // 4: wayplatform.testdata.v1.KitchenSink.map_string_int32:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringInt32Entry
+	19, // 5: wayplatform.testdata.v1.KitchenSink.map_int32_string:type_name -> wayplatform.testdata.v1.KitchenSink.MapInt32StringEntry
+	20, // 6: wayplatform.testdata.v1.KitchenSink.map_string_nested:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry
+	34, // 7: wayplatform.testdata.v1.KitchenSink.timestamp_value:type_name -> google.protobuf.Timestamp
+	35, // 8: wayplatform.testdata.v1.KitchenSink.duration_value:type_name -> google.protobuf.Duration
+	36, // 9: wayplatform.testdata.v1.KitchenSink.date_value:type_name -> google.type.Date
+	37, // 10: wayplatform.testdata.v1.KitchenSink.datetime_value:type_name -> google.type.DateTime
+	38, // 11: wayplatform.testdata.v1.KitchenSink.timeofday_value:type_name -> google.type.TimeOfDay
+	39, // 12: wayplatform.testdata.v1.KitchenSink.string_wrapper_value:type_name -> google.protobuf.StringValue
+	40, // 13: wayplatform.testdata.v1.KitchenSink.int32_wrapper_value:type_name -> google.protobuf.Int32Value
+	41, // 14: wayplatform.testdata.v1.KitchenSink.int64_wrapper_value:type_name -> google.protobuf.Int64Value
+	42, // 15: wayplatform.testdata.v1.KitchenSink.uint32_wrapper_value:type_name -> google.protobuf.UInt32Value
+	43, // 16: wayplatform.testdata.v1.KitchenSink.uint64_wrapper_value:type_name -> google.protobuf.UInt64Value
+	44, // 17: wayplatform.testdata.v1.KitchenSink.bool_wrapper_value:type_name -> google.protobuf.BoolValue
+	45, // 18: wayplatform.testdata.v1.KitchenSink.float_wrapper_value:type_name -> google.protobuf.FloatValue
+	46, // 19: wayplatform.testdata.v1.KitchenSink.double_wrapper_value:type_name -> google.protobuf.DoubleValue
+	47, // 20: wayplatform.testdata.v1.KitchenSink.bytes_wrapper_value:type_name -> google.protobuf.BytesValue
+	39, // 21: wayplatform.testdata.v1.KitchenSink.repeated_string_wrapper:type_name -> google.protobuf.StringValue
+	40, // 22: wayplatform.testdata.v1.KitchenSink.repeated_int32_wrapper:type_name -> google.protobuf.Int32Value
+	34, // 23: wayplatform.testdata.v1.KitchenSink.repeated_timestamp:type_name -> google.protobuf.Timestamp
+	21, // 24: wayplatform.testdata.v1.KitchenSink.map_string_int32_wrapper:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry
+	22, // 25: wayplatform.testdata.v1.KitchenSink.map_string_string_wrapper:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry
+	23, // 26: wayplatform.testdata.v1.KitchenSink.map_string_timestamp:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry
+	48, // 27: wayplatform.testdata.v1.KitchenSink.latlng_value:type_name -> google.type.LatLng
 	2,  // 28: wayplatform.testdata.v1.KitchenSink.date_range:type_name -> wayplatform.testdata.v1.DateRange
 	3,  // 29: wayplatform.testdata.v1.KitchenSink.timestamp_range:type_name -> wayplatform.testdata.v1.TimestampRange
 	4,  // 30: wayplatform.testdata.v1.KitchenSink.datetime_range:type_name -> wayplatform.testdata.v1.DateTimeRange
-	21, // 31: wayplatform.testdata.v1.KitchenSink.repeated_duration:type_name -> google.protobuf.Duration
-	34, // 32: wayplatform.testdata.v1.KitchenSink.repeated_latlng:type_name -> google.type.LatLng
+	35, // 31: wayplatform.testdata.v1.KitchenSink.repeated_duration:type_name -> google.protobuf.Duration
+	48, // 32: wayplatform.testdata.v1.KitchenSink.repeated_latlng:type_name -> google.type.LatLng
 	2,  // 33: wayplatform.testdata.v1.KitchenSink.repeated_date_range:type_name -> wayplatform.testdata.v1.DateRange
 	3,  // 34: wayplatform.testdata.v1.KitchenSink.repeated_timestamp_range:type_name -> wayplatform.testdata.v1.TimestampRange
-	22, // 35: wayplatform.testdata.v1.KitchenSink.repeated_date:type_name -> google.type.Date
-	23, // 36: wayplatform.testdata.v1.KitchenSink.repeated_datetime:type_name -> google.type.DateTime
-	24, // 37: wayplatform.testdata.v1.KitchenSink.repeated_timeofday:type_name -> google.type.TimeOfDay
-	13, // 38: wayplatform.testdata.v1.KitchenSink.map_string_duration:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry
-	14, // 39: wayplatform.testdata.v1.KitchenSink.map_string_latlng:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry
-	15, // 40: wayplatform.testdata.v1.KitchenSink.map_string_date_range:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry
-	16, // 41: wayplatform.testdata.v1.KitchenSink.map_string_date:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDateEntry
-	17, // 42: wayplatform.testdata.v1.KitchenSink.map_string_datetime:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry
-	18, // 43: wayplatform.testdata.v1.KitchenSink.map_string_timeofday:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry
-	20, // 44: wayplatform.testdata.v1.TimestampRange.start:type_name -> google.protobuf.Timestamp
-	20, // 45: wayplatform.testdata.v1.TimestampRange.end:type_name -> google.protobuf.Timestamp
-	20, // 46: wayplatform.testdata.v1.NestedMessage.timestamp_option:type_name -> google.protobuf.Timestamp
-	19, // 47: wayplatform.testdata.v1.NestedMessage.complex_option:type_name -> wayplatform.testdata.v1.NestedMessage.ComplexValue
-	5,  // 48: wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry.value:type_name -> wayplatform.testdata.v1.NestedMessage
-	26, // 49: wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry.value:type_name -> google.protobuf.Int32Value
-	25, // 50: wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry.value:type_name -> google.protobuf.StringValue
-	20, // 51: wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry.value:type_name -> google.protobuf.Timestamp
-	21, // 52: wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry.value:type_name -> google.protobuf.Duration
-	34, // 53: wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry.value:type_name -> google.type.LatLng
-	2,  // 54: wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry.value:type_name -> wayplatform.testdata.v1.DateRange
-	22, // 55: wayplatform.testdata.v1.KitchenSink.MapStringDateEntry.value:type_name -> google.type.Date
-	23, // 56: wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry.value:type_name -> google.type.DateTime
-	24, // 57: wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry.value:type_name -> google.type.TimeOfDay
-	20, // 58: wayplatform.testdata.v1.NestedMessage.ComplexValue.last_seen:type_name -> google.protobuf.Timestamp
-	59, // [59:59] is the sub-list for method output_type
-	59, // [59:59] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	36, // 35: wayplatform.testdata.v1.KitchenSink.repeated_date:type_name -> google.type.Date
+	37, // 36: wayplatform.testdata.v1.KitchenSink.repeated_datetime:type_name -> google.type.DateTime
+	38, // 37: wayplatform.testdata.v1.KitchenSink.repeated_timeofday:type_name -> google.type.TimeOfDay
+	24, // 38: wayplatform.testdata.v1.KitchenSink.map_string_duration:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry
+	25, // 39: wayplatform.testdata.v1.KitchenSink.map_string_latlng:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry
+	26, // 40: wayplatform.testdata.v1.KitchenSink.map_string_date_range:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry
+	27, // 41: wayplatform.testdata.v1.KitchenSink.map_string_date:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDateEntry
+	28, // 42: wayplatform.testdata.v1.KitchenSink.map_string_datetime:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry
+	29, // 43: wayplatform.testdata.v1.KitchenSink.map_string_timeofday:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry
+	49, // 44: wayplatform.testdata.v1.KitchenSink.any_value:type_name -> google.protobuf.Any
+	49, // 45: wayplatform.testdata.v1.KitchenSink.repeated_any:type_name -> google.protobuf.Any
+	50, // 46: wayplatform.testdata.v1.KitchenSink.json_value:type_name -> google.protobuf.Value
+	51, // 47: wayplatform.testdata.v1.KitchenSink.list_value:type_name -> google.protobuf.ListValue
+	52, // 48: wayplatform.testdata.v1.KitchenSink.struct_value:type_name -> google.protobuf.Struct
+	53, // 49: wayplatform.testdata.v1.KitchenSink.null_value:type_name -> google.protobuf.NullValue
+	50, // 50: wayplatform.testdata.v1.KitchenSink.repeated_value:type_name -> google.protobuf.Value
+	30, // 51: wayplatform.testdata.v1.KitchenSink.map_string_value:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringValueEntry
+	54, // 52: wayplatform.testdata.v1.KitchenSink.decimal_value:type_name -> google.type.Decimal
+	55, // 53: wayplatform.testdata.v1.KitchenSink.fraction_value:type_name -> google.type.Fraction
+	56, // 54: wayplatform.testdata.v1.KitchenSink.money_value:type_name -> google.type.Money
+	56, // 55: wayplatform.testdata.v1.KitchenSink.price:type_name -> google.type.Money
+	54, // 56: wayplatform.testdata.v1.KitchenSink.repeated_decimal:type_name -> google.type.Decimal
+	31, // 57: wayplatform.testdata.v1.KitchenSink.map_string_decimal:type_name -> wayplatform.testdata.v1.KitchenSink.MapStringDecimalEntry
+	5,  // 58: wayplatform.testdata.v1.KitchenSink.interval_value:type_name -> wayplatform.testdata.v1.Interval
+	6,  // 59: wayplatform.testdata.v1.KitchenSink.month_day_nanos:type_name -> wayplatform.testdata.v1.MonthDayNanos
+	5,  // 60: wayplatform.testdata.v1.KitchenSink.repeated_interval:type_name -> wayplatform.testdata.v1.Interval
+	7,  // 61: wayplatform.testdata.v1.KitchenSink.line_string:type_name -> wayplatform.testdata.v1.LineString
+	8,  // 62: wayplatform.testdata.v1.KitchenSink.polygon:type_name -> wayplatform.testdata.v1.Polygon
+	10, // 63: wayplatform.testdata.v1.KitchenSink.multi_point:type_name -> wayplatform.testdata.v1.MultiPoint
+	11, // 64: wayplatform.testdata.v1.KitchenSink.route:type_name -> wayplatform.testdata.v1.Route
+	8,  // 65: wayplatform.testdata.v1.KitchenSink.repeated_polygon:type_name -> wayplatform.testdata.v1.Polygon
+	34, // 66: wayplatform.testdata.v1.KitchenSink.timestamp_millis:type_name -> google.protobuf.Timestamp
+	35, // 67: wayplatform.testdata.v1.KitchenSink.duration_millis:type_name -> google.protobuf.Duration
+	34, // 68: wayplatform.testdata.v1.KitchenSink.repeated_timestamp_nanos:type_name -> google.protobuf.Timestamp
+	34, // 69: wayplatform.testdata.v1.TimestampRange.start:type_name -> google.protobuf.Timestamp
+	34, // 70: wayplatform.testdata.v1.TimestampRange.end:type_name -> google.protobuf.Timestamp
+	48, // 71: wayplatform.testdata.v1.LineString.points:type_name -> google.type.LatLng
+	9,  // 72: wayplatform.testdata.v1.Polygon.rings:type_name -> wayplatform.testdata.v1.LinearRing
+	48, // 73: wayplatform.testdata.v1.LinearRing.points:type_name -> google.type.LatLng
+	48, // 74: wayplatform.testdata.v1.MultiPoint.points:type_name -> google.type.LatLng
+	48, // 75: wayplatform.testdata.v1.Route.waypoints:type_name -> google.type.LatLng
+	34, // 76: wayplatform.testdata.v1.NestedMessage.timestamp_option:type_name -> google.protobuf.Timestamp
+	32, // 77: wayplatform.testdata.v1.NestedMessage.complex_option:type_name -> wayplatform.testdata.v1.NestedMessage.ComplexValue
+	12, // 78: wayplatform.testdata.v1.FieldPresence.nested:type_name -> wayplatform.testdata.v1.NestedMessage
+	12, // 79: wayplatform.testdata.v1.FieldPresence.required_nested:type_name -> wayplatform.testdata.v1.NestedMessage
+	52, // 80: wayplatform.testdata.v1.UnknownColumnsStruct.extra:type_name -> google.protobuf.Struct
+	16, // 81: wayplatform.testdata.v1.UnknownColumnsStruct.nested:type_name -> wayplatform.testdata.v1.UnknownColumnsMap
+	33, // 82: wayplatform.testdata.v1.UnknownColumnsMap.extra:type_name -> wayplatform.testdata.v1.UnknownColumnsMap.ExtraEntry
+	12, // 83: wayplatform.testdata.v1.KitchenSink.MapStringNestedEntry.value:type_name -> wayplatform.testdata.v1.NestedMessage
+	40, // 84: wayplatform.testdata.v1.KitchenSink.MapStringInt32WrapperEntry.value:type_name -> google.protobuf.Int32Value
+	39, // 85: wayplatform.testdata.v1.KitchenSink.MapStringStringWrapperEntry.value:type_name -> google.protobuf.StringValue
+	34, // 86: wayplatform.testdata.v1.KitchenSink.MapStringTimestampEntry.value:type_name -> google.protobuf.Timestamp
+	35, // 87: wayplatform.testdata.v1.KitchenSink.MapStringDurationEntry.value:type_name -> google.protobuf.Duration
+	48, // 88: wayplatform.testdata.v1.KitchenSink.MapStringLatlngEntry.value:type_name -> google.type.LatLng
+	2,  // 89: wayplatform.testdata.v1.KitchenSink.MapStringDateRangeEntry.value:type_name -> wayplatform.testdata.v1.DateRange
+	36, // 90: wayplatform.testdata.v1.KitchenSink.MapStringDateEntry.value:type_name -> google.type.Date
+	37, // 91: wayplatform.testdata.v1.KitchenSink.MapStringDatetimeEntry.value:type_name -> google.type.DateTime
+	38, // 92: wayplatform.testdata.v1.KitchenSink.MapStringTimeofdayEntry.value:type_name -> google.type.TimeOfDay
+	50, // 93: wayplatform.testdata.v1.KitchenSink.MapStringValueEntry.value:type_name -> google.protobuf.Value
+	54, // 94: wayplatform.testdata.v1.KitchenSink.MapStringDecimalEntry.value:type_name -> google.type.Decimal
+	34, // 95: wayplatform.testdata.v1.NestedMessage.ComplexValue.last_seen:type_name -> google.protobuf.Timestamp
+	96, // [96:96] is the sub-list for method output_type
+	96, // [96:96] is the sub-list for method input_type
+	96, // [96:96] is the sub-list for extension type_name
+	96, // [96:96] is the sub-list for extension extendee
+	0,  // [0:96] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_testdata_v1_kitchen_sink_proto_init() }
@@ -2684,7 +4692,7 @@ func file_wayplatform_testdata_v1_kitchen_sink_proto_init() {
 	if File_wayplatform_testdata_v1_kitchen_sink_proto != nil {
 		return
 	}
-	file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[4].OneofWrappers = []any{
+	file_wayplatform_testdata_v1_kitchen_sink_proto_msgTypes[11].OneofWrappers = []any{
 		(*nestedMessage_StringOption)(nil),
 		(*nestedMessage_IntOption)(nil),
 		(*nestedMessage_BoolOption)(nil),
@@ -2697,7 +4705,7 @@ func file_wayplatform_testdata_v1_kitchen_sink_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc), len(file_wayplatform_testdata_v1_kitchen_sink_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,277 @@
+package protobq
+
+import (
+	"fmt"
+	"math"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// IntervalMode controls how MessageLoader loads the year and month parts of INTERVAL values into
+// fields without calendar parts, such as google.protobuf.Duration.
+//
+// Days are always converted to 24 hours when the field has no days part.
+type IntervalMode int
+
+const (
+	// IntervalStrict returns ErrInvalidValue for INTERVAL values with year or month parts.
+	IntervalStrict IntervalMode = iota
+	// IntervalNormalize converts months to 30 days and years to 365 days.
+	IntervalNormalize
+)
+
+// String returns a string representation of the interval mode.
+func (m IntervalMode) String() string {
+	switch m {
+	case IntervalStrict:
+		return "strict"
+	case IntervalNormalize:
+		return "normalize"
+	default:
+		return fmt.Sprintf("IntervalMode(%d)", int(m))
+	}
+}
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 24 * secondsPerHour
+	daysPerMonth     = 30
+	daysPerYear      = 365
+)
+
+// intervalParts holds the calendar, day and time parts of an INTERVAL value.
+// Each part has its own sign, as in the canonical BigQuery format "Y-M D H:M:S.F".
+type intervalParts struct {
+	months  int64
+	days    int64
+	seconds int64
+	nanos   int64
+}
+
+func newIntervalParts(interval *bigquery.IntervalValue) intervalParts {
+	parts := intervalParts{
+		months: 12*int64(interval.Years) + int64(interval.Months),
+		days:   int64(interval.Days),
+		seconds: secondsPerHour*int64(interval.Hours) +
+			secondsPerMinute*int64(interval.Minutes) +
+			int64(interval.Seconds),
+		nanos: int64(interval.SubSecondNanos),
+	}
+	parts.seconds += parts.nanos / 1e9
+	parts.nanos %= 1e9
+	return parts.normalized()
+}
+
+// normalized gives the seconds and nanos the same sign.
+func (p intervalParts) normalized() intervalParts {
+	if p.seconds > 0 && p.nanos < 0 {
+		p.seconds--
+		p.nanos += 1e9
+	} else if p.seconds < 0 && p.nanos > 0 {
+		p.seconds++
+		p.nanos -= 1e9
+	}
+	return p
+}
+
+// withoutMonths folds the months into the days, according to the IntervalMode.
+func (p intervalParts) withoutMonths(mode IntervalMode) (intervalParts, error) {
+	if p.months == 0 {
+		return p, nil
+	}
+	if mode != IntervalNormalize {
+		return p, fmt.Errorf("INTERVAL with year or month parts requires IntervalNormalize")
+	}
+	p.days += daysPerYear*(p.months/12) + daysPerMonth*(p.months%12)
+	p.months = 0
+	return p, nil
+}
+
+// withoutDays folds the days into the seconds, as 24 hours.
+func (p intervalParts) withoutDays() intervalParts {
+	p.seconds += secondsPerDay * p.days
+	p.days = 0
+	return p.normalized()
+}
+
+// intervalValue converts an INTERVAL value, as a *bigquery.IntervalValue or a string, to a *bigquery.IntervalValue.
+func intervalValue(bqValue bigquery.Value) (*bigquery.IntervalValue, error) {
+	switch v := bqValue.(type) {
+	case *bigquery.IntervalValue:
+		return v, nil
+	case string:
+		interval, err := ParseInterval(v)
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "%w", err)
+		}
+		return interval, nil
+	default:
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for INTERVAL: %#v", bqValue)
+	}
+}
+
+// intervalDuration converts an INTERVAL value to a google.protobuf.Duration.
+func (o *MessageLoader) intervalDuration(interval *bigquery.IntervalValue) (*durationpb.Duration, error) {
+	parts, err := newIntervalParts(interval).withoutMonths(o.IntervalMode)
+	if err != nil {
+		return nil, newLoadError(ErrInvalidValue, interval, "can not load INTERVAL %s into %s: %w", interval, wktDuration, err)
+	}
+	parts = parts.withoutDays()
+	result := &durationpb.Duration{Seconds: parts.seconds, Nanos: int32(parts.nanos)}
+	if err := result.CheckValid(); err != nil {
+		return nil, newLoadError(ErrOutOfRange, interval, "can not load INTERVAL %s into %s: %w", interval, wktDuration, err)
+	}
+	return result, nil
+}
+
+// Names of the fields of interval messages.
+const (
+	intervalYears   = "years"
+	intervalMonths  = "months"
+	intervalDays    = "days"
+	intervalHours   = "hours"
+	intervalMinutes = "minutes"
+	intervalSeconds = "seconds"
+	intervalNanos   = "nanos"
+)
+
+// isIntervalMessage reports whether INTERVAL values are loaded into messages of the descriptor.
+// Interval messages only have singular integer fields named years, months, days, hours, minutes,
+// seconds or nanos, such as a message of months, days and nanos, or one with all of the parts.
+func isIntervalMessage(message protoreflect.MessageDescriptor) bool {
+	fields := message.Fields()
+	if fields.Len() == 0 {
+		return false
+	}
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if field.Cardinality() == protoreflect.Repeated {
+			return false
+		}
+		switch field.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		default:
+			return false
+		}
+		switch field.Name() {
+		case intervalYears, intervalMonths, intervalDays, intervalHours, intervalMinutes, intervalSeconds, intervalNanos:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// unmarshalInterval loads an INTERVAL value into an interval message.
+// Each part is loaded into the field of the part, or into the next smaller field if the message has no such field.
+// Year and month parts are converted to days according to the IntervalMode if the message has no calendar fields.
+func (o *MessageLoader) unmarshalInterval(bqValue bigquery.Value, message protoreflect.Message) error {
+	interval, err := intervalValue(bqValue)
+	if err != nil {
+		return err
+	}
+	fields := message.Descriptor().Fields()
+	years, months := fields.ByName(intervalYears), fields.ByName(intervalMonths)
+	days := fields.ByName(intervalDays)
+	parts := newIntervalParts(interval)
+	switch {
+	case years != nil && months != nil:
+		if err := setIntervalField(message, years, parts.months/12); err != nil {
+			return err
+		}
+		if err := setIntervalField(message, months, parts.months%12); err != nil {
+			return err
+		}
+	case months != nil:
+		if err := setIntervalField(message, months, parts.months); err != nil {
+			return err
+		}
+	case years != nil:
+		if parts.months%12 != 0 {
+			return newLoadError(
+				ErrPrecisionLoss, bqValue, "can not load INTERVAL %s into %s without months", interval, message.Descriptor().FullName(),
+			)
+		}
+		if err := setIntervalField(message, years, parts.months/12); err != nil {
+			return err
+		}
+	default:
+		if parts, err = parts.withoutMonths(o.IntervalMode); err != nil {
+			return newLoadError(ErrInvalidValue, bqValue, "can not load INTERVAL %s into %s: %w", interval, message.Descriptor().FullName(), err)
+		}
+	}
+	if days != nil {
+		if err := setIntervalField(message, days, parts.days); err != nil {
+			return err
+		}
+	} else {
+		parts = parts.withoutDays()
+	}
+	// Load the time part into the hours, minutes and seconds fields, largest first,
+	// and the remaining seconds into the nanos field.
+	seconds := parts.seconds
+	for _, unit := range []struct {
+		name    protoreflect.Name
+		seconds int64
+	}{
+		{name: intervalHours, seconds: secondsPerHour},
+		{name: intervalMinutes, seconds: secondsPerMinute},
+		{name: intervalSeconds, seconds: 1},
+	} {
+		if field := fields.ByName(unit.name); field != nil {
+			if err := setIntervalField(message, field, seconds/unit.seconds); err != nil {
+				return err
+			}
+			seconds %= unit.seconds
+		}
+	}
+	nanos := parts.nanos
+	if field := fields.ByName(intervalNanos); field != nil {
+		if seconds > math.MaxInt64/int64(1e9) || seconds < math.MinInt64/int64(1e9) {
+			return newLoadError(ErrOutOfRange, bqValue, "INTERVAL %s overflows %s", interval, field.FullName())
+		}
+		if err := setIntervalField(message, field, seconds*1e9+nanos); err != nil {
+			return err
+		}
+		seconds, nanos = 0, 0
+	}
+	if seconds != 0 || nanos != 0 {
+		return newLoadError(
+			ErrPrecisionLoss, bqValue, "can not load INTERVAL %s into %s without smaller parts", interval, message.Descriptor().FullName(),
+		)
+	}
+	return nil
+}
+
+func (o *MessageLoader) unmarshalIntervalListField(
+	bqListValue []bigquery.Value,
+	field protoreflect.FieldDescriptor,
+	message protoreflect.Message,
+) error {
+	list := message.Mutable(field).List()
+	for i, bqListElementValue := range bqListValue {
+		element := list.NewElement()
+		if err := o.unmarshalInterval(bqListElementValue, element.Message()); err != nil {
+			return wrapLoadError(err, indexSegment(i), nil, nil)
+		}
+		list.Append(element)
+	}
+	return nil
+}
+
+func setIntervalField(message protoreflect.Message, field protoreflect.FieldDescriptor, value int64) error {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if value < math.MinInt32 || value > math.MaxInt32 {
+			return newLoadError(ErrOutOfRange, value, "%d overflows %s", value, field.FullName())
+		}
+		message.Set(field, protoreflect.ValueOfInt32(int32(value)))
+	default:
+		message.Set(field, protoreflect.ValueOfInt64(value))
+	}
+	return nil
+}
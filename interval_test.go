@@ -0,0 +1,162 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestMessageLoader_interval(t *testing.T) {
+	newInterval := func(years, months, days, hours, minutes, seconds, nanos int32) *testdatav1.Interval {
+		var result testdatav1.Interval
+		result.SetYears(years)
+		result.SetMonths(months)
+		result.SetDays(days)
+		result.SetHours(hours)
+		result.SetMinutes(minutes)
+		result.SetSeconds(seconds)
+		result.SetNanos(nanos)
+		return &result
+	}
+	for _, test := range []struct {
+		name          string
+		mode          IntervalMode
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "Duration",
+			row:    []bigquery.Value{&bigquery.IntervalValue{Days: 2, Hours: 3, Minutes: 4, Seconds: 5, SubSecondNanos: 500000000}},
+			schema: bigquery.Schema{{Name: "duration_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationValue(&durationpb.Duration{Seconds: 2*86400 + 3*3600 + 4*60 + 5, Nanos: 500000000})
+				return &result
+			},
+		},
+		{
+			name:   "negative Duration",
+			row:    []bigquery.Value{&bigquery.IntervalValue{Days: 1, Seconds: -1, SubSecondNanos: -500000000}},
+			schema: bigquery.Schema{{Name: "duration_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationValue(&durationpb.Duration{Seconds: 86398, Nanos: 500000000})
+				return &result
+			},
+		},
+		{
+			name:   "normalized Duration",
+			mode:   IntervalNormalize,
+			row:    []bigquery.Value{&bigquery.IntervalValue{Years: 1, Months: 2}},
+			schema: bigquery.Schema{{Name: "duration_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDurationValue(&durationpb.Duration{Seconds: (365 + 2*30) * 86400})
+				return &result
+			},
+		},
+		{
+			name:          "Duration with months",
+			row:           []bigquery.Value{&bigquery.IntervalValue{Months: 1}},
+			schema:        bigquery.Schema{{Name: "duration_value", Type: bigquery.IntervalFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name: "interval message",
+			row: []bigquery.Value{
+				&bigquery.IntervalValue{Years: 1, Months: 14, Days: -3, Hours: 4, Minutes: 5, Seconds: 6, SubSecondNanos: 7},
+			},
+			schema: bigquery.Schema{{Name: "interval_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetIntervalValue(newInterval(2, 2, -3, 4, 5, 6, 7))
+				return &result
+			},
+		},
+		{
+			name:   "interval message from string",
+			row:    []bigquery.Value{"1-2 3 -4:5:6.5"},
+			schema: bigquery.Schema{{Name: "interval_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetIntervalValue(newInterval(1, 2, 3, -4, -5, -6, -500000000))
+				return &result
+			},
+		},
+		{
+			name:   "month day nanos",
+			row:    []bigquery.Value{&bigquery.IntervalValue{Years: 1, Months: 2, Days: 3, Hours: 4, Seconds: 5, SubSecondNanos: 6}},
+			schema: bigquery.Schema{{Name: "month_day_nanos", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				var monthDayNanos testdatav1.MonthDayNanos
+				monthDayNanos.SetMonths(14)
+				monthDayNanos.SetDays(3)
+				monthDayNanos.SetNanos((4*3600+5)*1e9 + 6)
+				result.SetMonthDayNanos(&monthDayNanos)
+				return &result
+			},
+		},
+		{
+			name:   "repeated interval messages",
+			row:    []bigquery.Value{[]bigquery.Value{&bigquery.IntervalValue{Days: 1}, "0-0 0 0:0:1"}},
+			schema: bigquery.Schema{{Name: "repeated_interval", Type: bigquery.IntervalFieldType, Repeated: true}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetRepeatedInterval([]*testdatav1.Interval{
+					newInterval(0, 0, 1, 0, 0, 0, 0),
+					newInterval(0, 0, 0, 0, 0, 1, 0),
+				})
+				return &result
+			},
+		},
+		{
+			name:   "string",
+			row:    []bigquery.Value{&bigquery.IntervalValue{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+			schema: bigquery.Schema{{Name: "string_value", Type: bigquery.IntervalFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetStringValue("1-2 3 4:5:6")
+				return &result
+			},
+		},
+		{
+			name:          "invalid string",
+			row:           []bigquery.Value{"1 day"},
+			schema:        bigquery.Schema{{Name: "interval_value", Type: bigquery.IntervalFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unsupported value",
+			row:           []bigquery.Value{int64(1)},
+			schema:        bigquery.Schema{{Name: "interval_value", Type: bigquery.IntervalFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}, IntervalMode: test.mode}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
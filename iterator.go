@@ -0,0 +1,104 @@
+package protobq
+
+import (
+	"errors"
+	"iter"
+	"reflect"
+
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// RowIterator is an iterator over BigQuery rows, such as *bigquery.RowIterator.
+type RowIterator interface {
+	// Next loads the next row into dst. It returns iterator.Done when there are no more rows.
+	Next(dst any) error
+}
+
+// Iterator iterates over BigQuery rows, loading each row into a new message of type T.
+type Iterator[T proto.Message] struct {
+	// Rows to iterate over.
+	Rows RowIterator
+
+	// MessageType of the messages to load, such as a dynamicpb.MessageType.
+	// It defaults to the message type of T, and is required if T is an interface type,
+	// such as proto.Message, or *dynamicpb.Message.
+	MessageType protoreflect.MessageType
+
+	// Loader used to load each row. Its Message is replaced by a new message for each row.
+	// Set Loader.Validator to validate each message.
+	Loader MessageLoader
+}
+
+// Next loads the next row into a new message.
+// It returns iterator.Done when there are no more rows.
+func (i *Iterator[T]) Next() (T, error) {
+	var zero T
+	message, err := newMessage[T](i.MessageType)
+	if err != nil {
+		return zero, err
+	}
+	i.Loader.Message = message
+	if err := i.Rows.Next(&i.Loader); err != nil {
+		return zero, err
+	}
+	return message, nil
+}
+
+// All returns an iterator over the remaining rows.
+// Iteration stops after the first error, which is yielded with a zero message.
+func (i *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			message, err := i.Next()
+			if errors.Is(err, iterator.Done) {
+				return
+			}
+			if !yield(message, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ReadAll loads all remaining rows into new messages of type T, with the default loader options.
+// Use Iterator.ReadAll to set a MessageType or loader options.
+func ReadAll[T proto.Message](rows RowIterator) ([]T, error) {
+	it := Iterator[T]{Rows: rows}
+	return it.ReadAll()
+}
+
+// ReadAll loads all remaining rows into new messages.
+func (i *Iterator[T]) ReadAll() ([]T, error) {
+	var result []T
+	for message, err := range i.All() {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, message)
+	}
+	return result, nil
+}
+
+// newMessage returns a new message of the message type, or of the message type of T if nil.
+func newMessage[T proto.Message](messageType protoreflect.MessageType) (T, error) {
+	var zero T
+	if messageType == nil {
+		switch any(zero).(type) {
+		case nil, *dynamicpb.Message:
+			return zero, newLoadError(
+				ErrUnsupportedType, nil, "MessageType is required to load messages of type %v", reflect.TypeFor[T](),
+			)
+		}
+		messageType = zero.ProtoReflect().Type()
+	}
+	message, ok := messageType.New().Interface().(T)
+	if !ok {
+		return zero, newLoadError(
+			ErrUnsupportedType, nil, "message type %s is not of type %v", messageType.Descriptor().FullName(), reflect.TypeFor[T](),
+		)
+	}
+	return message, nil
+}
@@ -0,0 +1,61 @@
+package protobq_test
+
+import (
+	"context"
+	"os"
+
+	"cloud.google.com/go/bigquery"
+	protobq "github.com/way-platform/protobq-go"
+	"google.golang.org/genproto/googleapis/example/library/v1"
+)
+
+func ExampleIterator() {
+	// 1. Connect to BigQuery.
+	ctx := context.Background()
+	client, err := bigquery.NewClient(ctx, os.Getenv("GOOGLE_CLOUD_PROJECT"))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	// 2. Run a query.
+	query := client.Query(`
+		SELECT
+			"George Orwell" as author,
+			"1984" as title,
+	`)
+	rows, err := query.Read(ctx)
+	if err != nil {
+		panic(err)
+	}
+	// 3. Iterate over the result as protobuf messages.
+	it := protobq.Iterator[*library.Book]{
+		Rows: rows,
+		Loader: protobq.MessageLoader{
+			DiscardUnknown: true,
+		},
+	}
+	for book, err := range it.All() {
+		if err != nil {
+			panic(err)
+		}
+		_ = book // 4. Profit.
+	}
+}
+
+func ExampleReadAll() {
+	ctx := context.Background()
+	client, err := bigquery.NewClient(ctx, os.Getenv("GOOGLE_CLOUD_PROJECT"))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	rows, err := client.Query(`SELECT "George Orwell" as author, "1984" as title`).Read(ctx)
+	if err != nil {
+		panic(err)
+	}
+	books, err := protobq.ReadAll[*library.Book](rows)
+	if err != nil {
+		panic(err)
+	}
+	_ = books
+}
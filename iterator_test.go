@@ -0,0 +1,227 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// fakeRowIterator is a RowIterator over in-memory rows.
+type fakeRowIterator struct {
+	schema bigquery.Schema
+	rows   [][]bigquery.Value
+	err    error
+}
+
+func (f *fakeRowIterator) Next(dst any) error {
+	if len(f.rows) == 0 {
+		if f.err != nil {
+			return f.err
+		}
+		return iterator.Done
+	}
+	row := f.rows[0]
+	f.rows = f.rows[1:]
+	return dst.(bigquery.ValueLoader).Load(row, f.schema)
+}
+
+func newNestedMessage(text string, number int32) *testdatav1.NestedMessage {
+	var result testdatav1.NestedMessage
+	result.SetText(text)
+	result.SetNumber(number)
+	return &result
+}
+
+func TestReadAll(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "text", Type: bigquery.StringFieldType},
+		{Name: "number", Type: bigquery.IntegerFieldType},
+	}
+	t.Run("rows", func(t *testing.T) {
+		actual, err := ReadAll[*testdatav1.NestedMessage](&fakeRowIterator{
+			schema: schema,
+			rows: [][]bigquery.Value{
+				{"a", int64(1)},
+				{"b", int64(2)},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []*testdatav1.NestedMessage{
+			newNestedMessage("a", 1),
+			newNestedMessage("b", 2),
+		}
+		if diff := cmp.Diff(expected, actual, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		actual, err := ReadAll[*testdatav1.NestedMessage](&fakeRowIterator{schema: schema})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(actual) != 0 {
+			t.Errorf("expected no messages, got %v", actual)
+		}
+	})
+	t.Run("load error", func(t *testing.T) {
+		_, err := ReadAll[*testdatav1.NestedMessage](&fakeRowIterator{
+			schema: schema,
+			rows: [][]bigquery.Value{
+				{"a", int64(1)},
+				{"b", "two"},
+			},
+		})
+		if !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected type mismatch, got %v", err)
+		}
+	})
+	t.Run("iterator error", func(t *testing.T) {
+		expected := errors.New("boom")
+		_, err := ReadAll[*testdatav1.NestedMessage](&fakeRowIterator{schema: schema, err: expected})
+		if !errors.Is(err, expected) {
+			t.Errorf("expected %v, got %v", expected, err)
+		}
+	})
+}
+
+func TestIterator_ReadAll(t *testing.T) {
+	descriptor := (&testdatav1.NestedMessage{}).ProtoReflect().Descriptor()
+	it := Iterator[*dynamicpb.Message]{
+		Rows: &fakeRowIterator{
+			schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "unknown", Type: bigquery.StringFieldType},
+			},
+			rows: [][]bigquery.Value{{"a", "x"}, {"b", "y"}},
+		},
+		MessageType: dynamicpb.NewMessageType(descriptor),
+		Loader:      MessageLoader{DiscardUnknown: true},
+	}
+	actual, err := it.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var texts []string
+	for _, message := range actual {
+		texts = append(texts, message.Get(descriptor.Fields().ByName("text")).String())
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, texts); diff != "" {
+		t.Errorf("unexpected messages (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestIterator(t *testing.T) {
+	it := Iterator[*testdatav1.NestedMessage]{
+		Rows: &fakeRowIterator{
+			schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "unknown", Type: bigquery.StringFieldType},
+			},
+			rows: [][]bigquery.Value{
+				{"a", "x"},
+				{"b", "y"},
+			},
+		},
+		Loader: MessageLoader{DiscardUnknown: true},
+	}
+	first, err := it.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := it.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Error("expected a new message per row")
+	}
+	if first.GetText() != "a" || second.GetText() != "b" {
+		t.Errorf("unexpected messages: %v, %v", first, second)
+	}
+	if _, err := it.Next(); err != iterator.Done {
+		t.Errorf("expected iterator.Done, got %v", err)
+	}
+}
+
+func TestIterator_validator(t *testing.T) {
+	it := Iterator[*testdatav1.NestedMessage]{
+		Rows: &fakeRowIterator{
+			schema: bigquery.Schema{{Name: "text", Type: bigquery.StringFieldType}},
+			rows:   [][]bigquery.Value{{"a"}, {""}},
+		},
+		Loader: MessageLoader{
+			Validator: func(message proto.Message) error {
+				if message.(*testdatav1.NestedMessage).GetText() == "" {
+					return errors.New("empty text")
+				}
+				return nil
+			},
+		},
+	}
+	if _, err := it.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := it.Next(); !errors.Is(err, ErrValidation) {
+		t.Errorf("expected %v, got %v", ErrValidation, err)
+	}
+}
+
+func TestIterator_messageType(t *testing.T) {
+	schema := bigquery.Schema{{Name: "text", Type: bigquery.StringFieldType}}
+	descriptor := (&testdatav1.NestedMessage{}).ProtoReflect().Descriptor()
+	t.Run("dynamic message", func(t *testing.T) {
+		it := Iterator[*dynamicpb.Message]{
+			Rows:        &fakeRowIterator{schema: schema, rows: [][]bigquery.Value{{"a"}}},
+			MessageType: dynamicpb.NewMessageType(descriptor),
+		}
+		message, err := it.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text := message.Get(descriptor.Fields().ByName("text")).String(); text != "a" {
+			t.Errorf("expected text a, got %q", text)
+		}
+	})
+	t.Run("interface type", func(t *testing.T) {
+		it := Iterator[proto.Message]{
+			Rows:        &fakeRowIterator{schema: schema, rows: [][]bigquery.Value{{"a"}}},
+			MessageType: (&testdatav1.NestedMessage{}).ProtoReflect().Type(),
+		}
+		message, err := it.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.NestedMessage
+		expected.SetText("a")
+		if diff := cmp.Diff(proto.Message(&expected), message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("missing message type", func(t *testing.T) {
+		rows := &fakeRowIterator{schema: schema, rows: [][]bigquery.Value{{"a"}}}
+		if _, err := (&Iterator[proto.Message]{Rows: rows}).Next(); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("expected %v, got %v", ErrUnsupportedType, err)
+		}
+		if _, err := (&Iterator[*dynamicpb.Message]{Rows: rows}).Next(); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("expected %v, got %v", ErrUnsupportedType, err)
+		}
+	})
+	t.Run("mismatched message type", func(t *testing.T) {
+		it := Iterator[*testdatav1.NestedMessage]{
+			Rows:        &fakeRowIterator{schema: schema, rows: [][]bigquery.Value{{"a"}}},
+			MessageType: dynamicpb.NewMessageType(descriptor),
+		}
+		if _, err := it.Next(); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("expected %v, got %v", ErrUnsupportedType, err)
+		}
+	})
+}
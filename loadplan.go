@@ -0,0 +1,382 @@
+package protobq
+
+import (
+	"slices"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxLoadPlans bounds the number of load plans cached by a MessageLoader.
+const maxLoadPlans = 1024
+
+// loadPlan is the compiled plan for loading rows of a BigQuery schema into messages of a descriptor.
+// It resolves the field and the loader of each column once, instead of for each row.
+type loadPlan struct {
+	// schema, message and field mask the plan was compiled for.
+	schema  bigquery.Schema
+	message protoreflect.MessageDescriptor
+	mask    *fieldMask
+	// columns holds the plan of each column of the schema.
+	columns []columnPlan
+	// discriminators holds the indexes of the oneof discriminator columns.
+	discriminators []int
+	// currencies holds the currency columns of google.type.Money fields.
+	currencies []currencyPlan
+	// hasOneofs reports whether the message has oneofs.
+	hasOneofs bool
+	// unknownColumns is the field storing the columns without field, if any.
+	unknownColumns protoreflect.FieldDescriptor
+	// err is the error of the plan, such as a masked field without a corresponding column, if any.
+	err *LoadError
+}
+
+// columnPlan is the compiled plan for loading a column into a field.
+type columnPlan struct {
+	// field of the column, nil if the message has no field for the column.
+	field protoreflect.FieldDescriptor
+	// discriminator is the oneof discriminated by the column, if any.
+	discriminator protoreflect.OneofDescriptor
+	// reserved reports whether the column without field has a reserved name.
+	reserved bool
+	// currency reports whether the column without field is the currency column of a google.type.Money field.
+	currency bool
+	// masked reports whether the column is skipped, as its field is not in the field mask.
+	masked bool
+	// mask is the field mask of the field, nil if the field is masked as a whole.
+	mask *fieldMask
+	// singular reports whether the field is neither a list nor a map.
+	singular bool
+	// load loads a value of the column into the field.
+	load columnLoader
+}
+
+// currencyPlan pairs the column of a google.type.Money field with the column of its currency code.
+type currencyPlan struct {
+	// money is the index of the column of the google.type.Money field.
+	money int
+	// column is the index of the currency column.
+	column int
+}
+
+// columnLoader loads a value of a column into the field of a message.
+type columnLoader func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) error
+
+// loadPlanKey identifies a schema, message descriptor and field mask.
+// Schemas are identified by their first field, as the BigQuery client reuses the same schema for each row.
+type loadPlanKey struct {
+	schema  *bigquery.FieldSchema
+	size    int
+	message protoreflect.MessageDescriptor
+	mask    *fieldMask
+}
+
+// loadPlanCache holds the load plans of a MessageLoader.
+type loadPlanCache struct {
+	// owner is the loader the plans were compiled for, to not share plans between copies of a loader.
+	owner *MessageLoader
+	plans map[loadPlanKey]*loadPlan
+}
+
+// loadPlan returns the load plan for the schema, message descriptor and field mask, compiling it on first use.
+func (o *MessageLoader) loadPlan(
+	bqSchema bigquery.Schema,
+	message protoreflect.MessageDescriptor,
+	mask *fieldMask,
+) *loadPlan {
+	key := loadPlanKey{size: len(bqSchema), message: message, mask: mask}
+	if len(bqSchema) > 0 {
+		key.schema = bqSchema[0]
+	}
+	if o.planCache == nil || o.planCache.owner != o || len(o.planCache.plans) >= maxLoadPlans {
+		o.planCache = &loadPlanCache{owner: o, plans: make(map[loadPlanKey]*loadPlan)}
+	}
+	if plan, ok := o.planCache.plans[key]; ok && plan.matches(bqSchema) {
+		return plan
+	}
+	plan := o.compileLoadPlan(bqSchema, message, mask)
+	o.planCache.plans[key] = plan
+	return plan
+}
+
+// matches reports whether the plan was compiled for the fields of the schema.
+func (p *loadPlan) matches(bqSchema bigquery.Schema) bool {
+	for i, bqFieldSchema := range bqSchema {
+		if p.schema[i] != bqFieldSchema {
+			return false
+		}
+	}
+	return true
+}
+
+// currencyField returns the google.type.Money field of the currency column at the index, or nil.
+func (p *loadPlan) currencyField(column int) protoreflect.FieldDescriptor {
+	for _, currency := range p.currencies {
+		if currency.column == column {
+			return p.columns[currency.money].field
+		}
+	}
+	return nil
+}
+
+func (o *MessageLoader) compileLoadPlan(
+	bqSchema bigquery.Schema,
+	message protoreflect.MessageDescriptor,
+	mask *fieldMask,
+) *loadPlan {
+	plan := &loadPlan{
+		schema:    bqSchema,
+		message:   message,
+		mask:      mask,
+		columns:   make([]columnPlan, len(bqSchema)),
+		hasOneofs: message.Oneofs().Len() > 0,
+	}
+	keepUnknown := mask == nil
+	if plan.unknownColumns = unknownColumnsField(message); plan.unknownColumns != nil {
+		plan.err = checkUnknownColumnsField(plan.unknownColumns)
+		_, keepUnknown = mask.field(plan.unknownColumns)
+	}
+	for i, bqFieldSchema := range bqSchema {
+		column := &plan.columns[i]
+		column.field = o.resolveField(message, bqFieldSchema.Name)
+		if column.field == nil {
+			column.discriminator = discriminatedOneof(message, bqFieldSchema.Name)
+			if column.discriminator != nil {
+				plan.discriminators = append(plan.discriminators, i)
+			}
+			column.reserved = message.ReservedNames().Has(protoreflect.Name(bqFieldSchema.Name))
+			column.masked = !keepUnknown
+			continue
+		}
+		var ok bool
+		if column.mask, ok = mask.field(column.field); !ok {
+			column.field, column.masked = nil, true
+			continue
+		}
+		switch {
+		case column.field.IsList():
+			column.load = compileListLoader(bqFieldSchema, column.field)
+		case column.field.IsMap():
+			column.load = compileMapLoader(bqFieldSchema, column.field)
+		default:
+			column.singular = true
+			column.load = compileSingularLoader(bqFieldSchema, column.field, column.mask)
+		}
+	}
+	if mask != nil && plan.err == nil {
+		plan.err = o.checkFieldMask(bqSchema, message, plan.columns, mask)
+	}
+	for i, bqFieldSchema := range bqSchema {
+		field := plan.columns[i].field
+		if field == nil || !isNumericFieldType(bqFieldSchema.Type) {
+			continue
+		}
+		name, ok := currencyColumnOption(field)
+		if !ok {
+			continue
+		}
+		for j, currencyFieldSchema := range bqSchema {
+			if currencyFieldSchema.Name == name {
+				plan.currencies = append(plan.currencies, currencyPlan{money: i, column: j})
+				plan.columns[j].currency = plan.columns[j].field == nil
+				break
+			}
+		}
+	}
+	return plan
+}
+
+// checkFieldMask returns an error for the first masked field without a corresponding column.
+// Masked fields of RECORD columns are checked against the schema of the column, regardless of the values of a row.
+func (o *MessageLoader) checkFieldMask(
+	bqSchema bigquery.Schema,
+	message protoreflect.MessageDescriptor,
+	columns []columnPlan,
+	mask *fieldMask,
+) *LoadError {
+	unknownColumns := unknownColumnsField(message)
+	for _, name := range mask.names() {
+		i := slices.IndexFunc(columns, func(column columnPlan) bool {
+			return column.field != nil && column.field.Name() == name
+		})
+		if i == -1 && unknownColumns != nil && unknownColumns.Name() == name {
+			// The field stores the unknown columns.
+			continue
+		}
+		if i == -1 {
+			err := newLoadError(ErrMissingColumn, nil, "no column for masked field %s", name)
+			return wrapLoadError(err, string(name), nil, message.Fields().ByName(name)).(*LoadError)
+		}
+		bqFieldSchema, column := bqSchema[i], columns[i]
+		if column.mask == nil || !isRecordMessageField(bqFieldSchema, column.field) {
+			continue
+		}
+		if nested := o.loadPlan(bqFieldSchema.Schema, column.field.Message(), column.mask); nested.err != nil {
+			err := *nested.err
+			return wrapLoadError(&err, bqFieldSchema.Name, bqFieldSchema, column.field).(*LoadError)
+		}
+	}
+	return nil
+}
+
+// isRecordMessageField reports whether the field is a message field loaded from the columns of a RECORD column.
+func isRecordMessageField(bqFieldSchema *bigquery.FieldSchema, field protoreflect.FieldDescriptor) bool {
+	return field.Message() != nil &&
+		!field.IsList() && !field.IsMap() &&
+		bqFieldSchema.Type == bigquery.RecordFieldType && !bqFieldSchema.Repeated &&
+		!isLoadedAsWellKnownType(field.Message().FullName(), bqFieldSchema.Type)
+}
+
+func compileListLoader(bqFieldSchema *bigquery.FieldSchema, field protoreflect.FieldDescriptor) columnLoader {
+	var loadList func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error
+	isMessage := field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind
+	switch {
+	case isMessage && isLoadedAsWellKnownType(field.Message().FullName(), bqFieldSchema.Type):
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalWellKnownTypeListField(bqList, field, message)
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.RangeFieldType:
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalRangeListField(bqList, bqFieldSchema, field, message)
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.IntervalFieldType && isIntervalMessage(field.Message()):
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalIntervalListField(bqList, field, message)
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.GeographyFieldType && isGeometryField(field):
+		shape, _ := geometryFieldShape(field)
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalGeometryListField(bqList, shape, field, message)
+		}
+	case isMessage:
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.loadMessageListField(bqList, bqFieldSchema, field, message)
+		}
+	default:
+		loadList = func(o *MessageLoader, bqList []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalScalarListField(bqList, field, message)
+		}
+	}
+	return func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) error {
+		if !bqFieldSchema.Repeated {
+			return newLoadError(ErrSchemaMismatch, bqField, "unsupported field schema for list field: not repeated")
+		}
+		bqList, ok := bqField.([]bigquery.Value)
+		if !ok {
+			return newLoadError(ErrTypeMismatch, bqField, "unsupported BigQuery value for message: %v", bqField)
+		}
+		if o.replacesLists() {
+			message.Clear(field)
+		}
+		return loadList(o, bqList, message)
+	}
+}
+
+func compileMapLoader(bqFieldSchema *bigquery.FieldSchema, field protoreflect.FieldDescriptor) columnLoader {
+	var loadMap func(o *MessageLoader, bqMap []bigquery.Value, message protoreflect.Message) error
+	mapValue := field.MapValue()
+	isMessage := mapValue.Kind() == protoreflect.MessageKind || mapValue.Kind() == protoreflect.GroupKind
+	var mapValueType bigquery.FieldType
+	if mapValueFieldSchema := mapValueFieldSchema(bqFieldSchema); mapValueFieldSchema != nil {
+		mapValueType = mapValueFieldSchema.Type
+	}
+	switch {
+	case isMessage && isLoadedAsWellKnownType(mapValue.Message().FullName(), mapValueType):
+		loadMap = func(o *MessageLoader, bqMap []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalWellKnownTypeValueMapField(bqMap, bqFieldSchema, field, message)
+		}
+	case isMessage && len(bqFieldSchema.Schema) >= 2 && bqFieldSchema.Schema[1].Type == bigquery.RangeFieldType:
+		loadMap = func(o *MessageLoader, bqMap []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalRangeValueMapField(bqMap, bqFieldSchema, field, message)
+		}
+	case isMessage:
+		loadMap = func(o *MessageLoader, bqMap []bigquery.Value, message protoreflect.Message) error {
+			return o.loadMessageValueMapField(bqMap, bqFieldSchema, field, message)
+		}
+	default:
+		loadMap = func(o *MessageLoader, bqMap []bigquery.Value, message protoreflect.Message) error {
+			return o.unmarshalScalarValueMapField(bqMap, bqFieldSchema, field, message)
+		}
+	}
+	return func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) error {
+		bqMap, ok := bqField.([]bigquery.Value)
+		if !ok {
+			return newLoadError(ErrTypeMismatch, bqField, "unsupported BigQuery value for message: %v", bqField)
+		}
+		return loadMap(o, bqMap, message)
+	}
+}
+
+func compileSingularLoader(
+	bqFieldSchema *bigquery.FieldSchema,
+	field protoreflect.FieldDescriptor,
+	mask *fieldMask,
+) columnLoader {
+	var loadValue func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) (protoreflect.Value, error)
+	isMessage := field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind
+	switch {
+	case isMessage && isLoadedAsWellKnownType(field.Message().FullName(), bqFieldSchema.Type):
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, _ protoreflect.Message) (protoreflect.Value, error) {
+			return o.unmarshalWellKnownTypeField(bqField, field)
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.RangeFieldType:
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) (protoreflect.Value, error) {
+			return o.unmarshalRangeField(bqField, field, message)
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.IntervalFieldType && isIntervalMessage(field.Message()):
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) (protoreflect.Value, error) {
+			fieldValue := message.NewField(field)
+			if err := o.unmarshalInterval(bqField, fieldValue.Message()); err != nil {
+				return protoreflect.ValueOf(nil), err
+			}
+			return fieldValue, nil
+		}
+	case isMessage && bqFieldSchema.Type == bigquery.GeographyFieldType && isGeometryField(field):
+		shape, _ := geometryFieldShape(field)
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) (protoreflect.Value, error) {
+			fieldValue := message.NewField(field)
+			if err := o.unmarshalGeometry(bqField, shape, fieldValue.Message()); err != nil {
+				return protoreflect.ValueOf(nil), err
+			}
+			return fieldValue, nil
+		}
+	case isMessage && bqFieldSchema.Type != bigquery.RecordFieldType:
+		loadValue = func(_ *MessageLoader, bqField bigquery.Value, _ protoreflect.Message) (protoreflect.Value, error) {
+			return protoreflect.ValueOf(nil), newLoadError(
+				ErrUnsupportedType, bqField, "unsupported BigQuery type for message: %v", bqFieldSchema.Type,
+			)
+		}
+	case isMessage:
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) (protoreflect.Value, error) {
+			bqMessage, ok := bqField.([]bigquery.Value)
+			if !ok {
+				return protoreflect.ValueOf(nil), newLoadError(
+					ErrTypeMismatch, bqField, "unsupported BigQuery value for message: %v", bqField,
+				)
+			}
+			fieldValue := o.mergeField(message, field)
+			if err := o.loadMessage(bqMessage, bqFieldSchema.Schema, fieldValue.Message(), mask); err != nil {
+				return protoreflect.ValueOf(nil), err
+			}
+			return fieldValue, nil
+		}
+	default:
+		loadValue = func(o *MessageLoader, bqField bigquery.Value, _ protoreflect.Message) (protoreflect.Value, error) {
+			return o.unmarshalScalar(bqField, bqFieldSchema, field)
+		}
+	}
+	isRecord := isRecordMessageField(bqFieldSchema, field)
+	return func(o *MessageLoader, bqField bigquery.Value, message protoreflect.Message) error {
+		if bqField == nil || isRecord && o.NullRecords && isNullRecord(bqField, bqFieldSchema.Schema) {
+			return o.loadNull(field, message)
+		}
+		value, err := loadValue(o, bqField, message)
+		if err != nil {
+			return err
+		}
+		if value.IsValid() {
+			message.Set(field, value)
+		}
+		return nil
+	}
+}
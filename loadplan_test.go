@@ -0,0 +1,43 @@
+package protobq
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+)
+
+func TestMessageLoader_loadPlan(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "created_by", Type: bigquery.StringFieldType},
+	}
+	descriptor := (&testdatav1.RenamedFields{}).ProtoReflect().Descriptor()
+	t.Run("cached", func(t *testing.T) {
+		var loader MessageLoader
+		plan := loader.loadPlan(schema, descriptor, nil)
+		if loader.loadPlan(schema, descriptor, nil) != plan {
+			t.Error("expected cached plan")
+		}
+		if loader.loadPlan(schema[:1], descriptor, nil) == plan {
+			t.Error("expected new plan for different schema")
+		}
+	})
+	t.Run("replaced field schema", func(t *testing.T) {
+		var loader MessageLoader
+		plan := loader.loadPlan(schema, descriptor, nil)
+		replaced := bigquery.Schema{schema[0], {Name: "author", Type: bigquery.StringFieldType}}
+		if loader.loadPlan(replaced, descriptor, nil) == plan {
+			t.Error("expected new plan for replaced field schema")
+		}
+	})
+	t.Run("copied loader", func(t *testing.T) {
+		var loader MessageLoader
+		_ = loader.loadPlan(schema, descriptor, nil)
+		copied := loader
+		copied.FieldResolver = ResolveFieldByJSONName
+		if field := copied.loadPlan(schema, descriptor, nil).columns[1].field; field != nil {
+			t.Errorf("expected no field for created_by by JSON name, got %v", field.FullName())
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package protobq
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ListMergeMode controls how MessageLoader merges the values of a row into repeated fields
+// that are already set, when MessageLoader.Merge is set.
+type ListMergeMode int
+
+const (
+	// ListAppend appends the values of the row to the existing values.
+	ListAppend ListMergeMode = iota
+	// ListReplace replaces the existing values with the values of the row.
+	ListReplace
+)
+
+// String returns a string representation of the list merge mode.
+func (m ListMergeMode) String() string {
+	switch m {
+	case ListAppend:
+		return "append"
+	case ListReplace:
+		return "replace"
+	default:
+		return fmt.Sprintf("ListMergeMode(%d)", int(m))
+	}
+}
+
+// NullMergeMode controls how MessageLoader merges NULL values of a row into fields that are already set,
+// when MessageLoader.Merge is set.
+type NullMergeMode int
+
+const (
+	// NullPreserve keeps the existing value of fields with a NULL column.
+	NullPreserve NullMergeMode = iota
+	// NullClear clears fields with a NULL column, and oneofs with a NULL discriminator column.
+	NullClear
+)
+
+// String returns a string representation of the null merge mode.
+func (m NullMergeMode) String() string {
+	switch m {
+	case NullPreserve:
+		return "preserve"
+	case NullClear:
+		return "clear"
+	default:
+		return fmt.Sprintf("NullMergeMode(%d)", int(m))
+	}
+}
+
+// clearsNull reports whether fields with a NULL column are cleared.
+func (o *MessageLoader) clearsNull() bool {
+	return o.Merge && o.NullMergeMode == NullClear
+}
+
+// replacesLists reports whether repeated fields are cleared before loading the values of a row.
+func (o *MessageLoader) replacesLists() bool {
+	return o.Merge && o.ListMergeMode == ListReplace
+}
+
+// mergeField returns the value of a singular message field to load a row into:
+// the existing message in merge mode, or a new message.
+func (o *MessageLoader) mergeField(message protoreflect.Message, field protoreflect.FieldDescriptor) protoreflect.Value {
+	if o.Merge && message.Has(field) {
+		return message.Mutable(field)
+	}
+	return message.NewField(field)
+}
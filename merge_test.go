@@ -0,0 +1,186 @@
+package protobq
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMessageLoader_merge(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "int64_value", Type: bigquery.IntegerFieldType},
+		{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+		{
+			Name:     "map_string_string",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.StringFieldType},
+			},
+		},
+		{
+			Name: "nested_message",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "number", Type: bigquery.IntegerFieldType},
+			},
+		},
+	}
+	row := []bigquery.Value{
+		nil,
+		int64(2),
+		[]bigquery.Value{"y"},
+		[]bigquery.Value{[]bigquery.Value{"k", "new"}},
+		[]bigquery.Value{nil, int64(5)},
+	}
+	existing := func() proto.Message {
+		var nested testdatav1.NestedMessage
+		nested.SetText("t")
+		nested.SetNumber(1)
+		var result testdatav1.KitchenSink
+		result.SetStringValue("a")
+		result.SetInt64Value(1)
+		result.SetBoolValue(true)
+		result.SetRepeatedString([]string{"x"})
+		result.SetMapStringString(map[string]string{"k": "old", "j": "keep"})
+		result.SetNestedMessage(&nested)
+		return &result
+	}
+	membersSchema := bigquery.Schema{
+		{Name: "optional_value", Type: bigquery.StringFieldType},
+		{Name: "string_option", Type: bigquery.StringFieldType},
+		{Name: "int_option", Type: bigquery.IntegerFieldType},
+	}
+	existingOption := func() proto.Message {
+		var result testdatav1.NestedMessage
+		result.SetText("t")
+		result.SetIntOption(1)
+		return &result
+	}
+	for _, test := range []struct {
+		name     string
+		loader   MessageLoader
+		existing func() proto.Message
+		row      []bigquery.Value
+		schema   bigquery.Schema
+		expected func() proto.Message
+	}{
+		{
+			name:     "reset",
+			existing: existing,
+			row:      row,
+			schema:   schema,
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetNumber(5)
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(2)
+				result.SetRepeatedString([]string{"y"})
+				result.SetMapStringString(map[string]string{"k": "new"})
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:     "append and preserve",
+			loader:   MessageLoader{Merge: true},
+			existing: existing,
+			row:      row,
+			schema:   schema,
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetText("t")
+				nested.SetNumber(5)
+				var result testdatav1.KitchenSink
+				result.SetStringValue("a")
+				result.SetInt64Value(2)
+				result.SetBoolValue(true)
+				result.SetRepeatedString([]string{"x", "y"})
+				result.SetMapStringString(map[string]string{"k": "new", "j": "keep"})
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:     "replace and clear",
+			loader:   MessageLoader{Merge: true, ListMergeMode: ListReplace, NullMergeMode: NullClear},
+			existing: existing,
+			row:      row,
+			schema:   schema,
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetNumber(5)
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(2)
+				result.SetBoolValue(true)
+				result.SetRepeatedString([]string{"y"})
+				result.SetMapStringString(map[string]string{"k": "new", "j": "keep"})
+				result.SetNestedMessage(&nested)
+				return &result
+			},
+		},
+		{
+			name:     "clear NULL record",
+			loader:   MessageLoader{Merge: true, NullMergeMode: NullClear},
+			existing: existing,
+			row:      []bigquery.Value{nil},
+			schema:   schema[4:],
+			expected: func() proto.Message {
+				result := existing().(*testdatav1.KitchenSink)
+				result.ClearNestedMessage()
+				return result
+			},
+		},
+		{
+			name:     "oneof case replaced",
+			loader:   MessageLoader{Merge: true},
+			existing: existingOption,
+			row:      []bigquery.Value{"string_option", nil, nil},
+			schema:   membersSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				result.SetStringOption("")
+				return &result
+			},
+		},
+		{
+			name:     "oneof preserved",
+			loader:   MessageLoader{Merge: true},
+			existing: existingOption,
+			row:      []bigquery.Value{nil, nil, nil},
+			schema:   membersSchema,
+			expected: existingOption,
+		},
+		{
+			name:     "oneof cleared",
+			loader:   MessageLoader{Merge: true, NullMergeMode: NullClear},
+			existing: existingOption,
+			row:      []bigquery.Value{nil, nil, nil},
+			schema:   membersSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				return &result
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := test.loader
+			loader.Message = test.existing()
+			if err := loader.Load(test.row, test.schema); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,19 +3,20 @@ package protobq
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"math"
+	"math/big"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/bigquery"
 	"cloud.google.com/go/civil"
 	"google.golang.org/genproto/googleapis/type/date"
-	"google.golang.org/genproto/googleapis/type/datetime"
-	"google.golang.org/genproto/googleapis/type/latlng"
 	"google.golang.org/genproto/googleapis/type/timeofday"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -25,23 +26,121 @@ import (
 // The message is converted from a BigQuery row using the provided UnmarshalOptions.
 type MessageLoader struct {
 	// If DiscardUnknown is set, unknown fields are ignored.
+	// Messages with a field marked with the option (protobq.v1.field).unknown_columns store them in the field instead.
 	DiscardUnknown bool
 
+	// If Merge is set, rows are merged into the existing Message instead of resetting it first:
+	// scalar fields are overwritten, message fields are merged, map entries are inserted or replaced,
+	// and repeated fields are merged according to ListMergeMode. NULL columns are merged according to NullMergeMode.
+	Merge bool
+
+	// ListMergeMode controls how repeated fields are merged when Merge is set.
+	ListMergeMode ListMergeMode
+
+	// NullMergeMode controls how NULL columns are merged when Merge is set.
+	NullMergeMode NullMergeMode
+
+	// FieldMask restricts loading to the masked fields, identified by their proto names.
+	// Columns of other fields, and unknown columns, are skipped without decoding their values.
+	// A masked field without a corresponding column fails with ErrMissingColumn.
+	// If nil or empty, all fields are loaded.
+	FieldMask *fieldmaskpb.FieldMask
+
+	// FieldResolver resolves the field of each column.
+	// If nil, fields are resolved by their column option, and then by their proto name, using ResolveFieldByColumn.
+	// Fields are resolved once per schema and message type, so it must not be changed after the first Load.
+	FieldResolver FieldResolver
+
+	// NullMode controls how NULL values are loaded into singular fields.
+	// By default, NULL values leave fields unset.
+	NullMode NullMode
+
+	// If NullRecords is set, RECORD values whose columns are all NULL are loaded as NULL values,
+	// leaving the message field unset instead of setting an empty message.
+	NullRecords bool
+
+	// OneofMode controls how rows with multiple non-null members of a oneof are loaded.
+	OneofMode OneofMode
+
+	// CoercionMode controls how values are converted into scalar and wrapper fields of another type or range.
+	// By default, values are converted as Go conversions do, truncating out of range integers.
+	CoercionMode CoercionMode
+
+	// RoundingMode controls how NUMERIC and BIGNUMERIC values are rounded to the precision of integral fields.
+	// By default, values that need rounding fail with ErrPrecisionLoss. Floating point fields are always
+	// rounded to the nearest value.
+	RoundingMode RoundingMode
+
+	// IntervalMode controls how the year and month parts of INTERVAL values are loaded into fields without
+	// calendar parts. By default, INTERVAL values with year or month parts fail with ErrInvalidValue.
+	IntervalMode IntervalMode
+
+	// EpochUnit is the unit of integers holding timestamps. By default, microseconds.
+	// It is overridden by the field option (protobq.v1.field).epoch_unit.
+	EpochUnit EpochUnit
+
+	// DurationEpochUnit is the unit of integers holding durations. By default, seconds.
+	// It is overridden by the field option (protobq.v1.field).epoch_unit.
+	DurationEpochUnit EpochUnit
+
+	// DateEpochUnit is the unit of integers holding dates. By default, days.
+	// It is overridden by the field option (protobq.v1.field).epoch_unit.
+	DateEpochUnit EpochUnit
+
+	// TimeZone is the IANA time zone ID, such as "America/Los_Angeles", or the UTC offset, such as "-08:00",
+	// of google.type.DateTime fields. DATETIME values are loaded as civil times in the time zone, and
+	// TIMESTAMP values are converted to the time zone.
+	// If empty, DATETIME values are loaded without time offset, and TIMESTAMP values in the location of
+	// their time.Time: UTC as a time zone, and other locations as a UTC offset.
+	TimeZone string
+
+	// Validator, if set, validates each loaded message, such as with the protovalidate rules of ValidateRules.
+	// Violations of protovalidate rules are reported as a LoadError for each violation, joined with errors.Join.
+	Validator Validator
+
+	// Resolver resolves the message types packed into google.protobuf.Any fields.
+	// If nil, protoregistry.GlobalTypes is used.
+	Resolver interface {
+		protoregistry.MessageTypeResolver
+		protoregistry.ExtensionTypeResolver
+	}
+
 	// Message to load.
 	Message proto.Message
+
+	// fieldMaskCache holds the compiled FieldMask.
+	fieldMaskCache *fieldMaskCache
+
+	// timeZone is the parsed TimeZone.
+	timeZone *dateTimeZone
+
+	// planCache holds the load plans compiled for each schema and message descriptor.
+	planCache *loadPlanCache
 }
 
 var _ bigquery.ValueLoader = &MessageLoader{}
 
 // Load the bigquery.Value list into the given proto.Message using the given bigquery.Schema
 // using options in UnmarshalOptions object.
-// It will clear the message first before setting the fields. If it returns an error,
+// It will clear the message first before setting the fields, unless Merge is set. If it returns an error,
 // the given message may be partially set.
+// Errors are of type *LoadError, carrying the path of the offending column, except for the violations
+// reported by the Validator, which are joined with errors.Join; use errors.As to get the first *LoadError,
+// or the Unwrap() []error method of the error to get all of them.
 func (o *MessageLoader) Load(bqMessage []bigquery.Value, bqSchema bigquery.Schema) error {
-	proto.Reset(o.Message)
-	if err := o.loadMessage(bqMessage, bqSchema, o.Message.ProtoReflect()); err != nil {
+	if !o.Merge {
+		proto.Reset(o.Message)
+	}
+	mask, err := o.fieldMask(o.Message.ProtoReflect().Descriptor())
+	if err != nil {
+		return err
+	}
+	if err := o.loadMessage(bqMessage, bqSchema, o.Message.ProtoReflect(), mask); err != nil {
 		return err
 	}
+	if o.Validator != nil {
+		return o.validate(bqSchema)
+	}
 	return nil
 }
 
@@ -49,66 +148,74 @@ func (o *MessageLoader) loadMessage(
 	bqMessage []bigquery.Value,
 	bqSchema bigquery.Schema,
 	message protoreflect.Message,
+	mask *fieldMask,
 ) error {
 	if len(bqMessage) != len(bqSchema) {
-		return fmt.Errorf("message has %d fields but schema has %d fields", len(bqMessage), len(bqSchema))
+		return newLoadError(
+			ErrSchemaMismatch, bqMessage, "message has %d fields but schema has %d fields", len(bqMessage), len(bqSchema),
+		)
+	}
+	plan := o.loadPlan(bqSchema, message.Descriptor(), mask)
+	if plan.err != nil {
+		err := *plan.err
+		return &err
+	}
+	oneofs, err := o.newOneofLoader(bqMessage, bqSchema, plan)
+	if err != nil {
+		return err
 	}
 	for i, bqFieldSchema := range bqSchema {
 		bqField := bqMessage[i]
-		fieldName := protoreflect.Name(bqFieldSchema.Name)
-		field := message.Descriptor().Fields().ByName(fieldName)
-		if field == nil {
-			if !o.DiscardUnknown && !message.Descriptor().ReservedNames().Has(fieldName) {
-				return fmt.Errorf("unknown field: %s", fieldName)
-			}
-			continue
-		}
-		switch {
-		case field.IsList():
-			if err := o.loadListField(bqField, bqFieldSchema, field, message); err != nil {
-				return err
+		column := &plan.columns[i]
+		if column.field == nil {
+			if column.discriminator != nil || column.currency || column.masked {
+				continue
 			}
-		case field.IsMap():
-			if err := o.loadMapField(bqField, bqFieldSchema, field, message); err != nil {
-				return err
+			if plan.unknownColumns != nil && !column.reserved {
+				if err := setUnknownColumn(bqField, bqFieldSchema, plan.unknownColumns, message); err != nil {
+					return wrapLoadError(err, bqFieldSchema.Name, bqFieldSchema, plan.unknownColumns)
+				}
+				continue
 			}
-		default:
-			value, err := o.loadSingularField(bqField, bqFieldSchema, field, message)
-			if err != nil {
-				return err
+			if !o.DiscardUnknown && !column.reserved {
+				return wrapLoadError(
+					newLoadError(ErrUnknownField, bqField, "unknown field: %s", bqFieldSchema.Name),
+					bqFieldSchema.Name,
+					bqFieldSchema,
+					nil,
+				)
 			}
-			if value.IsValid() {
-				message.Set(field, value)
+			continue
+		}
+		if column.singular {
+			if skip, err := oneofs.skip(bqField, column.field); err != nil {
+				return wrapLoadError(err, bqFieldSchema.Name, bqFieldSchema, column.field)
+			} else if skip {
+				continue
 			}
 		}
+		if err := column.load(o, bqField, message); err != nil {
+			return wrapLoadError(err, bqFieldSchema.Name, bqFieldSchema, column.field)
+		}
+	}
+	for _, currency := range plan.currencies {
+		field := plan.columns[currency.money].field
+		if err := setMoneyCurrency(bqMessage[currency.column], field, message); err != nil {
+			return wrapLoadError(err, bqSchema[currency.column].Name, bqSchema[currency.column], field)
+		}
 	}
+	oneofs.setCases(message, o.clearsNull())
 	return nil
 }
 
-func (o *MessageLoader) loadListField(
-	bqField bigquery.Value,
-	bqFieldSchema *bigquery.FieldSchema,
-	field protoreflect.FieldDescriptor,
-	message protoreflect.Message,
-) error {
-	if !bqFieldSchema.Repeated {
-		return fmt.Errorf("%s: unsupported field schema for list field: not repeated", field.Name())
-	}
-	bqList, ok := bqField.([]bigquery.Value)
-	if !ok {
-		return fmt.Errorf("%s: unsupported BigQuery value for message: %v", field.Name(), bqField)
-	}
-	isMessage := field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind
-	switch {
-	case isMessage && isWellKnownType(string(field.Message().FullName())):
-		return o.unmarshalWellKnownTypeListField(bqList, field, message)
-	case isMessage && bqFieldSchema.Type == bigquery.RangeFieldType:
-		return o.unmarshalRangeListField(bqList, bqFieldSchema, field, message)
-	case isMessage:
-		return o.loadMessageListField(bqList, bqFieldSchema, field, message)
-	default:
-		return o.unmarshalScalarListField(bqList, field, message)
+func (o *MessageLoader) resolveField(
+	message protoreflect.MessageDescriptor,
+	column string,
+) protoreflect.FieldDescriptor {
+	if o.FieldResolver == nil {
+		return ResolveFieldByColumn(message, column)
 	}
+	return o.FieldResolver(message, column)
 }
 
 func (o *MessageLoader) loadMessageListField(
@@ -118,54 +225,34 @@ func (o *MessageLoader) loadMessageListField(
 	message protoreflect.Message,
 ) error {
 	list := message.Mutable(field).List()
-	for _, bqElement := range bqListValue {
+	for i, bqElement := range bqListValue {
 		if bqFieldSchema.Type != bigquery.RecordFieldType {
-			return fmt.Errorf(
-				"%s: field schema has type %s but expected %s",
-				field.Name(),
+			return newLoadError(
+				ErrSchemaMismatch,
+				bqElement,
+				"field schema has type %s but expected %s",
 				bqFieldSchema.Type,
 				bigquery.RecordFieldType,
 			)
 		}
 		bqMessageElement, ok := bqElement.([]bigquery.Value)
 		if !ok {
-			return fmt.Errorf(
-				"%s: unsupported BigQuery value for message: %v", field.Name(), bqMessageElement,
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqElement, "unsupported BigQuery value for message: %v", bqElement),
+				indexSegment(i),
+				nil,
+				nil,
 			)
 		}
 		listElementValue := list.NewElement()
-		if err := o.loadMessage(bqMessageElement, bqFieldSchema.Schema, listElementValue.Message()); err != nil {
-			return err
+		if err := o.loadMessage(bqMessageElement, bqFieldSchema.Schema, listElementValue.Message(), nil); err != nil {
+			return wrapLoadError(err, indexSegment(i), nil, nil)
 		}
 		list.Append(listElementValue)
 	}
 	return nil
 }
 
-func (o *MessageLoader) loadMapField(
-	bqField bigquery.Value,
-	bqFieldSchema *bigquery.FieldSchema,
-	field protoreflect.FieldDescriptor,
-	message protoreflect.Message,
-) error {
-	bqMapField, ok := bqField.([]bigquery.Value)
-	if !ok {
-		return fmt.Errorf("%s: unsupported BigQuery value for message: %v", field.Name(), bqField)
-	}
-	mapValue := field.MapValue()
-	isMessage := mapValue.Kind() == protoreflect.MessageKind || mapValue.Kind() == protoreflect.GroupKind
-	switch {
-	case isMessage && isWellKnownType(string(mapValue.Message().FullName())):
-		return o.unmarshalWellKnownTypeValueMapField(bqMapField, bqFieldSchema, field, message)
-	case isMessage && len(bqFieldSchema.Schema) >= 2 && bqFieldSchema.Schema[1].Type == bigquery.RangeFieldType:
-		return o.unmarshalRangeValueMapField(bqMapField, bqFieldSchema, field, message)
-	case isMessage:
-		return o.loadMessageValueMapField(bqMapField, bqFieldSchema, field, message)
-	default:
-		return o.unmarshalScalarValueMapField(bqMapField, field, message)
-	}
-}
-
 func (o *MessageLoader) loadMessageValueMapField(
 	bqMapField []bigquery.Value,
 	bqFieldSchema *bigquery.FieldSchema,
@@ -173,7 +260,7 @@ func (o *MessageLoader) loadMessageValueMapField(
 	message protoreflect.Message,
 ) error {
 	mapField := message.Mutable(field).Map()
-	for _, bqMapEntry := range bqMapField {
+	for i, bqMapEntry := range bqMapField {
 		// Handle null/nil map entries
 		if bqMapEntry == nil {
 			continue
@@ -187,7 +274,7 @@ func (o *MessageLoader) loadMessageValueMapField(
 			}
 			// Process non-empty map entry
 			if err := o.processMapEntry(entryMap, bqFieldSchema, field, mapField); err != nil {
-				return err
+				return wrapLoadError(err, mapEntrySegment(i, bqMapEntry), nil, nil)
 			}
 		} else if entryArray, ok := bqMapEntry.([]bigquery.Value); ok {
 			// Handle array format entries (BigQuery REPEATED RECORD format: [key, value])
@@ -196,10 +283,15 @@ func (o *MessageLoader) loadMessageValueMapField(
 				continue
 			}
 			if err := o.loadArrayMapEntry(entryArray, bqFieldSchema, field, mapField); err != nil {
-				return err
+				return wrapLoadError(err, mapEntrySegment(i, bqMapEntry), nil, nil)
 			}
 		} else {
-			return fmt.Errorf("%s: unsupported BigQuery value for map entry: %v", field.Name(), bqMapEntry)
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqMapEntry, "unsupported BigQuery value for map entry: %v", bqMapEntry),
+				indexSegment(i),
+				nil,
+				nil,
+			)
 		}
 	}
 	return nil
@@ -217,19 +309,24 @@ func (o *MessageLoader) processMapEntry(
 	}
 	bqMapEntryValue, ok := bqMapEntry["value"]
 	if !ok {
-		return fmt.Errorf("%s: map entry is missing value field", field.Name())
+		return newLoadError(ErrSchemaMismatch, bqMapEntry, "map entry is missing value field")
+	}
+	if len(bqFieldSchema.Schema) != 2 || bqFieldSchema.Schema[1].Name != "value" {
+		return newLoadError(ErrSchemaMismatch, bqMapEntry, "unsupported BigQuery schema for map entry")
 	}
 	bqMapEntryMessageValue, ok := bqMapEntryValue.([]bigquery.Value)
 	if !ok {
-		return fmt.Errorf("%s: unsupported BigQuery value for message: %v", field.Name(), bqMapEntryValue)
-	}
-	if len(bqFieldSchema.Schema) != 2 || bqFieldSchema.Schema[1].Name != "value" {
-		return fmt.Errorf("%s: unsupported BigQuery schema for map entry", field.Name())
+		return wrapLoadError(
+			newLoadError(ErrTypeMismatch, bqMapEntryValue, "unsupported BigQuery value for message: %v", bqMapEntryValue),
+			"value",
+			bqFieldSchema.Schema[1],
+			field.MapValue(),
+		)
 	}
 	bqMapEntryValueSchema := bqFieldSchema.Schema[1].Schema
 	mapEntryValue := mapField.NewValue()
-	if err := o.loadMessage(bqMapEntryMessageValue, bqMapEntryValueSchema, mapEntryValue.Message()); err != nil {
-		return err
+	if err := o.loadMessage(bqMapEntryMessageValue, bqMapEntryValueSchema, mapEntryValue.Message(), nil); err != nil {
+		return wrapLoadError(err, "value", bqFieldSchema.Schema[1], field.MapValue())
 	}
 	mapField.Set(mapEntryKey, mapEntryValue)
 	return nil
@@ -244,79 +341,58 @@ func (o *MessageLoader) loadArrayMapEntry(
 	// BigQuery REPEATED RECORD format: [key, value]
 	// Expected schema: [{Name: "key", Type: STRING}, {Name: "value", Type: RECORD, Schema: [...]}]
 	if len(bqFieldSchema.Schema) != 2 {
-		return fmt.Errorf("%s: unsupported BigQuery schema for array-format map entry", field.Name())
+		return newLoadError(ErrSchemaMismatch, bqMapEntryArray, "unsupported BigQuery schema for array-format map entry")
 	}
 	if len(bqMapEntryArray) != 2 {
-		return fmt.Errorf("%s: array-format map entry must have exactly 2 elements [key, value], got %d", field.Name(), len(bqMapEntryArray))
+		return newLoadError(
+			ErrSchemaMismatch,
+			bqMapEntryArray,
+			"array-format map entry must have exactly 2 elements [key, value], got %d",
+			len(bqMapEntryArray),
+		)
 	}
 	// Extract key (first element)
 	bqMapEntryKey := bqMapEntryArray[0]
 	mapEntryKey := protoreflect.ValueOf(bqMapEntryKey).MapKey()
 	// Extract value (second element)
 	bqMapEntryValue := bqMapEntryArray[1]
-	bqMapEntryMessageValue, ok := bqMapEntryValue.([]bigquery.Value)
-	if !ok {
-		return fmt.Errorf("%s: unsupported BigQuery value for message in array-format entry: %v", field.Name(), bqMapEntryValue)
-	}
 	// Validate and extract value schema
 	if bqFieldSchema.Schema[1].Name != "value" {
-		return fmt.Errorf("%s: expected 'value' field in schema position 1 for array-format map entry", field.Name())
+		return newLoadError(
+			ErrSchemaMismatch, bqMapEntryArray, "expected 'value' field in schema position 1 for array-format map entry",
+		)
+	}
+	bqMapEntryMessageValue, ok := bqMapEntryValue.([]bigquery.Value)
+	if !ok {
+		return wrapLoadError(
+			newLoadError(
+				ErrTypeMismatch, bqMapEntryValue, "unsupported BigQuery value for message in array-format entry: %v", bqMapEntryValue,
+			),
+			"value",
+			bqFieldSchema.Schema[1],
+			field.MapValue(),
+		)
 	}
 	bqMapEntryValueSchema := bqFieldSchema.Schema[1].Schema
 	// Load the message value
 	mapEntryValue := mapField.NewValue()
-	if err := o.loadMessage(bqMapEntryMessageValue, bqMapEntryValueSchema, mapEntryValue.Message()); err != nil {
-		return err
+	if err := o.loadMessage(bqMapEntryMessageValue, bqMapEntryValueSchema, mapEntryValue.Message(), nil); err != nil {
+		return wrapLoadError(err, "value", bqFieldSchema.Schema[1], field.MapValue())
 	}
 	mapField.Set(mapEntryKey, mapEntryValue)
 	return nil
 }
 
-func (o *MessageLoader) loadSingularField(
-	bqField bigquery.Value,
-	bqFieldSchema *bigquery.FieldSchema,
-	field protoreflect.FieldDescriptor,
-	message protoreflect.Message,
-) (protoreflect.Value, error) {
-	if bqField == nil {
-		return protoreflect.ValueOf(nil), nil
-	}
-	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
-		if isWellKnownType(string(field.Message().FullName())) {
-			return o.unmarshalWellKnownTypeField(bqField, field)
-		}
-		// Handle BigQuery RANGE types
-		if bqFieldSchema.Type == bigquery.RangeFieldType {
-			return o.unmarshalRangeField(bqField, field, message)
-		}
-		if bqFieldSchema.Type != bigquery.RecordFieldType {
-			return protoreflect.ValueOf(nil), fmt.Errorf(
-				"%s: unsupported BigQuery type for message: %v", field.Name(), bqFieldSchema.Type,
-			)
-		}
-		bqMessage, ok := bqField.([]bigquery.Value)
-		if !ok {
-			return protoreflect.ValueOf(nil), fmt.Errorf("unsupported BigQuery value for message: %v", bqMessage)
-		}
-		fieldValue := message.NewField(field)
-		if err := o.loadMessage(bqMessage, bqFieldSchema.Schema, fieldValue.Message()); err != nil {
-			return protoreflect.ValueOf(nil), fmt.Errorf("%s: %w", field.Name(), err)
-		}
-		return fieldValue, nil
-	}
-	return o.unmarshalScalar(bqField, bqFieldSchema, field)
-}
-
 func (o *MessageLoader) unmarshalWellKnownTypeListField(
 	bqListValue []bigquery.Value,
 	field protoreflect.FieldDescriptor,
 	message protoreflect.Message,
 ) error {
 	list := message.Mutable(field).List()
-	for _, bqListElementValue := range bqListValue {
+	for i, bqListElementValue := range bqListValue {
 		value, err := o.unmarshalWellKnownTypeField(bqListElementValue, field)
 		if err != nil {
-			return err
+			return wrapLoadError(err, indexSegment(i), nil, nil)
 		}
 		list.Append(value)
 	}
@@ -330,14 +406,19 @@ func (o *MessageLoader) unmarshalRangeListField(
 	message protoreflect.Message,
 ) error {
 	list := message.Mutable(field).List()
-	for _, bqListElementValue := range bqListValue {
+	for i, bqListElementValue := range bqListValue {
 		// Create a new list element for the range message
 		listElementValue := list.NewElement()
 
 		// Manually unmarshal the range into the list element message
 		rangeValue, ok := bqListElementValue.(*bigquery.RangeValue)
 		if !ok {
-			return fmt.Errorf("unsupported BigQuery value for RANGE: %T", bqListElementValue)
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqListElementValue, "unsupported BigQuery value for RANGE: %T", bqListElementValue),
+				indexSegment(i),
+				nil,
+				nil,
+			)
 		}
 
 		rangeMessage := listElementValue.Message()
@@ -347,14 +428,16 @@ func (o *MessageLoader) unmarshalRangeListField(
 		startField := rangeMessage.Descriptor().Fields().ByName("start")
 		endField := rangeMessage.Descriptor().Fields().ByName("end")
 		if startField == nil || endField == nil {
-			return fmt.Errorf("invalid range message type: missing start or end field in %s", messageName)
+			return newLoadError(
+				ErrUnsupportedType, nil, "invalid range message type: missing start or end field in %s", messageName,
+			)
 		}
 
 		// Handle start value
 		if rangeValue.Start != nil {
 			startValue, err := o.unmarshalRangeValue(rangeValue.Start, startField, messageName)
 			if err != nil {
-				return fmt.Errorf("error unmarshaling range start: %w", err)
+				return wrapLoadError(err, indexSegment(i)+".start", nil, startField)
 			}
 			if startValue.IsValid() {
 				rangeMessage.Set(startField, startValue)
@@ -365,7 +448,7 @@ func (o *MessageLoader) unmarshalRangeListField(
 		if rangeValue.End != nil {
 			endValue, err := o.unmarshalRangeValue(rangeValue.End, endField, messageName)
 			if err != nil {
-				return fmt.Errorf("error unmarshaling range end: %w", err)
+				return wrapLoadError(err, indexSegment(i)+".end", nil, endField)
 			}
 			if endValue.IsValid() {
 				rangeMessage.Set(endField, endValue)
@@ -383,10 +466,10 @@ func (o *MessageLoader) unmarshalScalarListField(
 	message protoreflect.Message,
 ) error {
 	list := message.Mutable(field).List()
-	for _, bqListElementValue := range bqListValue {
+	for i, bqListElementValue := range bqListValue {
 		value, err := o.unmarshalScalar(bqListElementValue, nil, field)
 		if err != nil {
-			return err
+			return wrapLoadError(err, indexSegment(i), nil, nil)
 		}
 		list.Append(value)
 	}
@@ -395,11 +478,12 @@ func (o *MessageLoader) unmarshalScalarListField(
 
 func (o *MessageLoader) unmarshalScalarValueMapField(
 	bqMapField []bigquery.Value,
+	bqFieldSchema *bigquery.FieldSchema,
 	field protoreflect.FieldDescriptor,
 	message protoreflect.Message,
 ) error {
 	mapField := message.Mutable(field).Map()
-	for _, bqMapEntry := range bqMapField {
+	for i, bqMapEntry := range bqMapField {
 		// Handle null/nil map entries
 		if bqMapEntry == nil {
 			continue
@@ -414,15 +498,22 @@ func (o *MessageLoader) unmarshalScalarValueMapField(
 			// Process non-empty map entry
 			mapEntryKey, err := o.unmarshalMapEntryKey(entryMap)
 			if err != nil {
-				return err
+				return wrapLoadError(err, indexSegment(i), nil, nil)
 			}
 			bqMapEntryValue, ok := entryMap["value"]
 			if !ok {
-				return fmt.Errorf("%s: map entry is missing value field", field.Name())
+				return wrapLoadError(
+					newLoadError(ErrSchemaMismatch, bqMapEntry, "map entry is missing value field"),
+					mapEntrySegment(i, bqMapEntry),
+					nil,
+					nil,
+				)
 			}
 			mapEntryValue, err := o.unmarshalScalar(bqMapEntryValue, nil, field.MapValue())
 			if err != nil {
-				return err
+				return wrapLoadError(
+					err, mapEntrySegment(i, bqMapEntry)+".value", mapValueFieldSchema(bqFieldSchema), field.MapValue(),
+				)
 			}
 			mapField.Set(mapEntryKey, mapEntryValue)
 		} else if entryArray, ok := bqMapEntry.([]bigquery.Value); ok {
@@ -431,11 +522,16 @@ func (o *MessageLoader) unmarshalScalarValueMapField(
 				// Skip empty array entries
 				continue
 			}
-			if err := o.processArrayScalarMapEntry(entryArray, field, mapField); err != nil {
-				return err
+			if err := o.processArrayScalarMapEntry(entryArray, bqFieldSchema, field, mapField); err != nil {
+				return wrapLoadError(err, mapEntrySegment(i, bqMapEntry), nil, nil)
 			}
 		} else {
-			return fmt.Errorf("%s: unsupported BigQuery value for map entry: %v", field.Name(), bqMapEntry)
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqMapEntry, "unsupported BigQuery value for map entry: %v", bqMapEntry),
+				indexSegment(i),
+				nil,
+				nil,
+			)
 		}
 	}
 	return nil
@@ -443,12 +539,18 @@ func (o *MessageLoader) unmarshalScalarValueMapField(
 
 func (o *MessageLoader) processArrayScalarMapEntry(
 	bqMapEntryArray []bigquery.Value,
+	bqFieldSchema *bigquery.FieldSchema,
 	field protoreflect.FieldDescriptor,
 	mapField protoreflect.Map,
 ) error {
 	// BigQuery REPEATED RECORD format for scalar values: [key, value]
 	if len(bqMapEntryArray) != 2 {
-		return fmt.Errorf("%s: array-format map entry must have exactly 2 elements [key, value], got %d", field.Name(), len(bqMapEntryArray))
+		return newLoadError(
+			ErrSchemaMismatch,
+			bqMapEntryArray,
+			"array-format map entry must have exactly 2 elements [key, value], got %d",
+			len(bqMapEntryArray),
+		)
 	}
 
 	// Extract key (first element)
@@ -459,7 +561,7 @@ func (o *MessageLoader) processArrayScalarMapEntry(
 	bqMapEntryValue := bqMapEntryArray[1]
 	mapEntryValue, err := o.unmarshalScalar(bqMapEntryValue, nil, field.MapValue())
 	if err != nil {
-		return err
+		return wrapLoadError(err, "value", mapValueFieldSchema(bqFieldSchema), field.MapValue())
 	}
 
 	mapField.Set(mapEntryKey, mapEntryValue)
@@ -473,7 +575,7 @@ func (o *MessageLoader) unmarshalRangeValueMapField(
 	message protoreflect.Message,
 ) error {
 	mapField := message.Mutable(field).Map()
-	for _, bqMapEntry := range bqMapField {
+	for i, bqMapEntry := range bqMapField {
 		// Handle null/nil map entries
 		if bqMapEntry == nil {
 			continue
@@ -488,18 +590,28 @@ func (o *MessageLoader) unmarshalRangeValueMapField(
 			// Process non-empty map entry
 			mapEntryKey, err := o.unmarshalMapEntryKey(entryMap)
 			if err != nil {
-				return err
+				return wrapLoadError(err, indexSegment(i), nil, nil)
 			}
 			bqMapEntryValue, ok := entryMap["value"]
 			if !ok {
-				return fmt.Errorf("%s: map entry is missing value field", field.Name())
+				return wrapLoadError(
+					newLoadError(ErrSchemaMismatch, bqMapEntry, "map entry is missing value field"),
+					mapEntrySegment(i, bqMapEntry),
+					nil,
+					nil,
+				)
 			}
 
 			// Handle Range type in map value - create new value and unmarshal range into it
 			mapEntryValue := mapField.NewValue()
 			rangeValue, ok := bqMapEntryValue.(*bigquery.RangeValue)
 			if !ok {
-				return fmt.Errorf("unsupported BigQuery value for RANGE: %T", bqMapEntryValue)
+				return wrapLoadError(
+					newLoadError(ErrTypeMismatch, bqMapEntryValue, "unsupported BigQuery value for RANGE: %T", bqMapEntryValue),
+					mapEntrySegment(i, bqMapEntry)+".value",
+					mapValueFieldSchema(bqFieldSchema),
+					field.MapValue(),
+				)
 			}
 
 			rangeMessage := mapEntryValue.Message()
@@ -509,14 +621,16 @@ func (o *MessageLoader) unmarshalRangeValueMapField(
 			startField := rangeMessage.Descriptor().Fields().ByName("start")
 			endField := rangeMessage.Descriptor().Fields().ByName("end")
 			if startField == nil || endField == nil {
-				return fmt.Errorf("invalid range message type: missing start or end field in %s", messageName)
+				return newLoadError(
+					ErrUnsupportedType, nil, "invalid range message type: missing start or end field in %s", messageName,
+				)
 			}
 
 			// Handle start value
 			if rangeValue.Start != nil {
 				startValue, err := o.unmarshalRangeValue(rangeValue.Start, startField, messageName)
 				if err != nil {
-					return fmt.Errorf("error unmarshaling range start: %w", err)
+					return wrapLoadError(err, mapEntrySegment(i, bqMapEntry)+".value.start", nil, startField)
 				}
 				if startValue.IsValid() {
 					rangeMessage.Set(startField, startValue)
@@ -527,7 +641,7 @@ func (o *MessageLoader) unmarshalRangeValueMapField(
 			if rangeValue.End != nil {
 				endValue, err := o.unmarshalRangeValue(rangeValue.End, endField, messageName)
 				if err != nil {
-					return fmt.Errorf("error unmarshaling range end: %w", err)
+					return wrapLoadError(err, mapEntrySegment(i, bqMapEntry)+".value.end", nil, endField)
 				}
 				if endValue.IsValid() {
 					rangeMessage.Set(endField, endValue)
@@ -542,10 +656,15 @@ func (o *MessageLoader) unmarshalRangeValueMapField(
 				continue
 			}
 			if err := o.processArrayRangeMapEntry(entryArray, bqFieldSchema, field, mapField); err != nil {
-				return err
+				return wrapLoadError(err, mapEntrySegment(i, bqMapEntry), nil, nil)
 			}
 		} else {
-			return fmt.Errorf("%s: unsupported BigQuery value for map entry: %v", field.Name(), bqMapEntry)
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqMapEntry, "unsupported BigQuery value for map entry: %v", bqMapEntry),
+				indexSegment(i),
+				nil,
+				nil,
+			)
 		}
 	}
 	return nil
@@ -559,7 +678,12 @@ func (o *MessageLoader) processArrayRangeMapEntry(
 ) error {
 	// BigQuery REPEATED RECORD format for range values: [key, range_value]
 	if len(bqMapEntryArray) != 2 {
-		return fmt.Errorf("%s: array-format map entry must have exactly 2 elements [key, value], got %d", field.Name(), len(bqMapEntryArray))
+		return newLoadError(
+			ErrSchemaMismatch,
+			bqMapEntryArray,
+			"array-format map entry must have exactly 2 elements [key, value], got %d",
+			len(bqMapEntryArray),
+		)
 	}
 
 	// Extract key (first element)
@@ -570,7 +694,14 @@ func (o *MessageLoader) processArrayRangeMapEntry(
 	bqMapEntryValue := bqMapEntryArray[1]
 	rangeValue, ok := bqMapEntryValue.(*bigquery.RangeValue)
 	if !ok {
-		return fmt.Errorf("unsupported BigQuery value for RANGE in array-format entry: %T", bqMapEntryValue)
+		return wrapLoadError(
+			newLoadError(
+				ErrTypeMismatch, bqMapEntryValue, "unsupported BigQuery value for RANGE in array-format entry: %T", bqMapEntryValue,
+			),
+			"value",
+			mapValueFieldSchema(bqFieldSchema),
+			field.MapValue(),
+		)
 	}
 
 	// Handle Range type - create new value and unmarshal range into it
@@ -582,14 +713,16 @@ func (o *MessageLoader) processArrayRangeMapEntry(
 	startField := rangeMessage.Descriptor().Fields().ByName("start")
 	endField := rangeMessage.Descriptor().Fields().ByName("end")
 	if startField == nil || endField == nil {
-		return fmt.Errorf("invalid range message type: missing start or end field in %s", messageName)
+		return newLoadError(
+			ErrUnsupportedType, nil, "invalid range message type: missing start or end field in %s", messageName,
+		)
 	}
 
 	// Handle start value
 	if rangeValue.Start != nil {
 		startValue, err := o.unmarshalRangeValue(rangeValue.Start, startField, messageName)
 		if err != nil {
-			return fmt.Errorf("error unmarshaling range start: %w", err)
+			return wrapLoadError(err, "value.start", nil, startField)
 		}
 		if startValue.IsValid() {
 			rangeMessage.Set(startField, startValue)
@@ -600,7 +733,7 @@ func (o *MessageLoader) processArrayRangeMapEntry(
 	if rangeValue.End != nil {
 		endValue, err := o.unmarshalRangeValue(rangeValue.End, endField, messageName)
 		if err != nil {
-			return fmt.Errorf("error unmarshaling range end: %w", err)
+			return wrapLoadError(err, "value.end", nil, endField)
 		}
 		if endValue.IsValid() {
 			rangeMessage.Set(endField, endValue)
@@ -618,7 +751,7 @@ func (o *MessageLoader) unmarshalWellKnownTypeValueMapField(
 	message protoreflect.Message,
 ) error {
 	mapField := message.Mutable(field).Map()
-	for _, bqMapEntry := range bqMapField {
+	for i, bqMapEntry := range bqMapField {
 		// Handle null/nil map entries
 		if bqMapEntry == nil {
 			continue
@@ -633,17 +766,24 @@ func (o *MessageLoader) unmarshalWellKnownTypeValueMapField(
 			// Process non-empty map entry
 			mapEntryKey, err := o.unmarshalMapEntryKey(entryMap)
 			if err != nil {
-				return err
+				return wrapLoadError(err, indexSegment(i), nil, nil)
 			}
 			bqMapEntryValue, ok := entryMap["value"]
 			if !ok {
-				return fmt.Errorf("%s: map entry is missing value field", field.Name())
+				return wrapLoadError(
+					newLoadError(ErrSchemaMismatch, bqMapEntry, "map entry is missing value field"),
+					mapEntrySegment(i, bqMapEntry),
+					nil,
+					nil,
+				)
 			}
 
 			// Handle regular well-known type
 			mapEntryValue, err := o.unmarshalWellKnownTypeField(bqMapEntryValue, field.MapValue())
 			if err != nil {
-				return err
+				return wrapLoadError(
+					err, mapEntrySegment(i, bqMapEntry)+".value", mapValueFieldSchema(bqFieldSchema), field.MapValue(),
+				)
 			}
 			mapField.Set(mapEntryKey, mapEntryValue)
 		} else if entryArray, ok := bqMapEntry.([]bigquery.Value); ok {
@@ -652,11 +792,16 @@ func (o *MessageLoader) unmarshalWellKnownTypeValueMapField(
 				// Skip empty array entries
 				continue
 			}
-			if err := o.processArrayWellKnownTypeMapEntry(entryArray, field, mapField); err != nil {
-				return err
+			if err := o.processArrayWellKnownTypeMapEntry(entryArray, bqFieldSchema, field, mapField); err != nil {
+				return wrapLoadError(err, mapEntrySegment(i, bqMapEntry), nil, nil)
 			}
 		} else {
-			return fmt.Errorf("%s: unsupported BigQuery value for map entry: %v", field.Name(), bqMapEntry)
+			return wrapLoadError(
+				newLoadError(ErrTypeMismatch, bqMapEntry, "unsupported BigQuery value for map entry: %v", bqMapEntry),
+				indexSegment(i),
+				nil,
+				nil,
+			)
 		}
 	}
 	return nil
@@ -664,12 +809,18 @@ func (o *MessageLoader) unmarshalWellKnownTypeValueMapField(
 
 func (o *MessageLoader) processArrayWellKnownTypeMapEntry(
 	bqMapEntryArray []bigquery.Value,
+	bqFieldSchema *bigquery.FieldSchema,
 	field protoreflect.FieldDescriptor,
 	mapField protoreflect.Map,
 ) error {
 	// BigQuery REPEATED RECORD format for well-known type values: [key, value]
 	if len(bqMapEntryArray) != 2 {
-		return fmt.Errorf("%s: array-format map entry must have exactly 2 elements [key, value], got %d", field.Name(), len(bqMapEntryArray))
+		return newLoadError(
+			ErrSchemaMismatch,
+			bqMapEntryArray,
+			"array-format map entry must have exactly 2 elements [key, value], got %d",
+			len(bqMapEntryArray),
+		)
 	}
 
 	// Extract key (first element)
@@ -682,19 +833,27 @@ func (o *MessageLoader) processArrayWellKnownTypeMapEntry(
 	// Handle well-known type
 	mapEntryValue, err := o.unmarshalWellKnownTypeField(bqMapEntryValue, field.MapValue())
 	if err != nil {
-		return err
+		return wrapLoadError(err, "value", mapValueFieldSchema(bqFieldSchema), field.MapValue())
 	}
 
 	mapField.Set(mapEntryKey, mapEntryValue)
 	return nil
 }
 
+// mapValueFieldSchema returns the field schema of the value column of a map entry, if any.
+func mapValueFieldSchema(bqFieldSchema *bigquery.FieldSchema) *bigquery.FieldSchema {
+	if bqFieldSchema == nil || len(bqFieldSchema.Schema) != 2 {
+		return nil
+	}
+	return bqFieldSchema.Schema[1]
+}
+
 func (o *MessageLoader) unmarshalMapEntryKey(
 	bqMapEntry map[string]bigquery.Value,
 ) (protoreflect.MapKey, error) {
 	bqMapEntryKey, ok := bqMapEntry["key"]
 	if !ok {
-		return protoreflect.MapKey{}, fmt.Errorf("map entry is missing key field")
+		return protoreflect.MapKey{}, newLoadError(ErrSchemaMismatch, bqMapEntry, "map entry is missing key field")
 	}
 	return protoreflect.ValueOf(bqMapEntryKey).MapKey(), nil
 }
@@ -707,19 +866,31 @@ func (o *MessageLoader) unmarshalWellKnownTypeField(
 	var err error
 	switch field.Message().FullName() {
 	case wktTimestamp:
-		result, err = o.unmarshalTimestamp(bqValue)
+		result, err = o.unmarshalTimestamp(bqValue, field)
 	case wktDuration:
-		result, err = o.unmarshalDuration(bqValue)
+		result, err = o.unmarshalDuration(bqValue, field)
 	case wktTimeOfDay:
 		result, err = o.unmarshalTimeOfDay(bqValue)
 	case wktDate:
-		result, err = o.unmarshalDate(bqValue)
+		result, err = o.unmarshalDate(bqValue, field)
 	case kwtDateTime:
 		result, err = o.unmarshalDateTime(bqValue)
 	case wktLatLng:
 		result, err = o.unmarshalLatLng(bqValue)
 	case wktStruct:
 		result, err = o.unmarshalStruct(bqValue)
+	case wktValue:
+		result, err = o.unmarshalValue(bqValue)
+	case wktListValue:
+		result, err = o.unmarshalListValue(bqValue)
+	case wktAny:
+		result, err = o.unmarshalAny(bqValue)
+	case wktDecimal:
+		result, err = o.unmarshalDecimal(bqValue)
+	case wktMoney:
+		result, err = o.unmarshalMoney(bqValue, field)
+	case wktFraction:
+		result, err = o.unmarshalFraction(bqValue)
 	case wktDoubleValue:
 		result, err = o.unmarshalDoubleValue(bqValue)
 	case wktFloatValue:
@@ -739,72 +910,73 @@ func (o *MessageLoader) unmarshalWellKnownTypeField(
 	case wktBytesValue:
 		result, err = o.unmarshalBytesValue(bqValue)
 	default:
-		result, err = nil, fmt.Errorf("unsupported well-known-type: %s", field.Message().FullName())
+		result, err = nil, newLoadError(ErrUnsupportedType, bqValue, "unsupported well-known-type: %s", field.Message().FullName())
 	}
 	if err != nil {
+		if _, ok := err.(*LoadError); !ok {
+			err = newLoadError(ErrInvalidValue, bqValue, "%w", err)
+		}
 		return protoreflect.ValueOf(nil), err
 	}
 	return protoreflect.ValueOf(result.ProtoReflect()), nil
 }
 
-func (o *MessageLoader) unmarshalTimestamp(bqValue bigquery.Value) (*timestamppb.Timestamp, error) {
+func (o *MessageLoader) unmarshalTimestamp(
+	bqValue bigquery.Value,
+	field protoreflect.FieldDescriptor,
+) (*timestamppb.Timestamp, error) {
 	switch v := bqValue.(type) {
 	case time.Time:
-		return timestamppb.New(v), nil
+		return newTimestamp(bqValue, v)
 	case string:
 		// Parse RFC3339 string
 		t, err := time.Parse(time.RFC3339Nano, v)
 		if err != nil {
-			return nil, fmt.Errorf("invalid RFC3339 timestamp string for %s: %v: %w", wktTimestamp, v, err)
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid RFC3339 timestamp string for %s: %v: %w", wktTimestamp, v, err)
 		}
-		return timestamppb.New(t), nil
+		return newTimestamp(bqValue, t)
 	case int64:
-		// Assume microseconds since Unix epoch (BigQuery TIMESTAMP format)
-		return timestamppb.New(time.UnixMicro(v)), nil
+		// Microseconds since Unix epoch by default, as in BigQuery TIMESTAMP values
+		return epochTimestamp(bqValue, v, epochUnit(field, o.EpochUnit, EpochUnitMicroseconds))
 	case int32:
-		// Assume seconds since Unix epoch
-		return timestamppb.New(time.Unix(int64(v), 0)), nil
+		return epochTimestamp(bqValue, int64(v), epochUnit(field, o.EpochUnit, EpochUnitSeconds))
 	case uint32:
-		// Assume seconds since Unix epoch
-		return timestamppb.New(time.Unix(int64(v), 0)), nil
+		return epochTimestamp(bqValue, int64(v), epochUnit(field, o.EpochUnit, EpochUnitSeconds))
 	default:
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %v", wktTimestamp, bqValue)
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %v", wktTimestamp, bqValue)
 	}
 }
 
-func (o *MessageLoader) unmarshalDuration(bqValue bigquery.Value) (*durationpb.Duration, error) {
-	var duration time.Duration
+func (o *MessageLoader) unmarshalDuration(
+	bqValue bigquery.Value,
+	field protoreflect.FieldDescriptor,
+) (*durationpb.Duration, error) {
 	switch v := bqValue.(type) {
 	case int64:
-		duration = time.Duration(v) * time.Second
+		return newDurationFromEpoch(bqValue, v, epochUnit(field, o.DurationEpochUnit, EpochUnitSeconds))
 	case float64:
-		duration = time.Duration(v * float64(time.Second))
-	case string:
-		// Try to parse various string formats
-		var err error
-
-		// First try ISO8601 duration format (PT1H30M45.123S)
-		if duration, err = parseISO8601Duration(v); err == nil {
-			return durationpb.New(duration), nil
+		nanos := v * float64(epochUnit(field, o.DurationEpochUnit, EpochUnitSeconds).nanos())
+		if math.IsNaN(nanos) || nanos >= math.MaxInt64 || nanos < math.MinInt64 {
+			return nil, newLoadError(ErrOutOfRange, bqValue, "%v overflows %s", v, wktDuration)
 		}
-
-		// Try BigQuery interval format (H:MM:SS or H:MM:SS.sss)
-		if duration, err = parseBigQueryInterval(v); err == nil {
-			return durationpb.New(duration), nil
+		return durationpb.New(time.Duration(nanos)), nil
+	case *bigquery.IntervalValue:
+		return o.intervalDuration(v)
+	case string:
+		interval, err := ParseInterval(v)
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid duration string for %s: %w", wktDuration, err)
 		}
-
-		// If both fail, return error
-		return nil, fmt.Errorf("invalid duration string for %s: %v (tried ISO8601 and BigQuery interval formats)", wktDuration, v)
+		return o.intervalDuration(interval)
 	default:
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", wktDuration, bqValue)
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktDuration, bqValue)
 	}
-	return durationpb.New(duration), nil
 }
 
 func (o *MessageLoader) unmarshalTimeOfDay(bqValue bigquery.Value) (*timeofday.TimeOfDay, error) {
 	t, ok := bqValue.(civil.Time)
 	if !ok {
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", wktTimeOfDay, bqValue)
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktTimeOfDay, bqValue)
 	}
 	return &timeofday.TimeOfDay{
 		Hours:   int32(t.Hour),
@@ -814,184 +986,257 @@ func (o *MessageLoader) unmarshalTimeOfDay(bqValue bigquery.Value) (*timeofday.T
 	}, nil
 }
 
-func (o *MessageLoader) unmarshalDate(bqValue bigquery.Value) (*date.Date, error) {
-	d, ok := bqValue.(civil.Date)
-	if !ok {
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", wktDate, bqValue)
-	}
-	return &date.Date{
-		Year:  int32(d.Year),
-		Month: int32(d.Month),
-		Day:   int32(d.Day),
-	}, nil
-}
-
-func (o *MessageLoader) unmarshalDateTime(bqValue bigquery.Value) (*datetime.DateTime, error) {
+func (o *MessageLoader) unmarshalDate(bqValue bigquery.Value, field protoreflect.FieldDescriptor) (*date.Date, error) {
 	switch v := bqValue.(type) {
-	case civil.DateTime:
-		return &datetime.DateTime{
-			Year:    int32(v.Date.Year),
-			Month:   int32(v.Date.Month),
-			Day:     int32(v.Date.Day),
-			Hours:   int32(v.Time.Hour),
-			Minutes: int32(v.Time.Minute),
-			Seconds: int32(v.Time.Second),
-			Nanos:   int32(v.Time.Nanosecond),
-		}, nil
-	case time.Time:
-		name, offset := v.Zone()
-		var timeZone *datetime.TimeZone
-		if name != "" {
-			// Named timezone (e.g., "UTC", "PST")
-			timeZone = &datetime.TimeZone{Id: name}
-		} else {
-			// Numeric offset timezone (e.g., "+08:00", "-05:00")
-			var offsetStr string
-			if offset >= 0 {
-				offsetHours := offset / 3600
-				offsetMinutes := (offset % 3600) / 60
-				offsetStr = fmt.Sprintf("+%02d:%02d", offsetHours, offsetMinutes)
-			} else {
-				// For negative offsets, make sure we handle the sign correctly
-				absOffset := -offset
-				offsetHours := absOffset / 3600
-				offsetMinutes := (absOffset % 3600) / 60
-				offsetStr = fmt.Sprintf("-%02d:%02d", offsetHours, offsetMinutes)
-			}
-			timeZone = &datetime.TimeZone{Id: offsetStr}
-		}
-		return &datetime.DateTime{
-			Year:    int32(v.Year()),
-			Month:   int32(v.Month()),
-			Day:     int32(v.Day()),
-			Hours:   int32(v.Hour()),
-			Minutes: int32(v.Minute()),
-			Seconds: int32(v.Second()),
-			Nanos:   int32(v.Nanosecond()),
-			TimeOffset: &datetime.DateTime_TimeZone{
-				TimeZone: timeZone,
-			},
+	case civil.Date:
+		return &date.Date{
+			Year:  int32(v.Year),
+			Month: int32(v.Month),
+			Day:   int32(v.Day),
 		}, nil
+	case int64:
+		// Days since Unix epoch by default
+		return epochDate(bqValue, v, epochUnit(field, o.DateEpochUnit, EpochUnitDays))
 	default:
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", kwtDateTime, bqValue)
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktDate, bqValue)
 	}
 }
 
-func (o *MessageLoader) unmarshalLatLng(bqValue bigquery.Value) (*latlng.LatLng, error) {
-	s, ok := bqValue.(string)
-	if !ok {
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", wktLatLng, bqValue)
+// unmarshalStruct loads a Struct from a JSON object, as a string or as decoded by the BigQuery client.
+func (o *MessageLoader) unmarshalStruct(bqValue bigquery.Value) (*structpb.Struct, error) {
+	value, err := o.unmarshalValue(bqValue)
+	if err != nil {
+		return nil, err
 	}
-	latLng := &latlng.LatLng{}
-	if _, err := fmt.Sscanf(s, "POINT(%f %f)", &latLng.Longitude, &latLng.Latitude); err != nil {
-		return nil, fmt.Errorf("invalid GEOGRAPHY value for %s: %#v: %w", wktLatLng, bqValue, err)
+	structValue := value.GetStructValue()
+	if structValue == nil {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktStruct, bqValue)
 	}
-	return latLng, nil
+	return structValue, nil
 }
 
-func (o *MessageLoader) unmarshalStruct(bqValue bigquery.Value) (*structpb.Struct, error) {
-	s, ok := bqValue.(string)
-	if !ok {
-		return nil, fmt.Errorf("unsupported BigQuery value for %s: %#v", wktStruct, bqValue)
+// unmarshalListValue loads a ListValue from a JSON array, as a string or as decoded by the BigQuery client.
+func (o *MessageLoader) unmarshalListValue(bqValue bigquery.Value) (*structpb.ListValue, error) {
+	value, err := o.unmarshalValue(bqValue)
+	if err != nil {
+		return nil, err
 	}
-	var structValue structpb.Struct
-	if err := structValue.UnmarshalJSON([]byte(s)); err != nil {
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v: %w", wktStruct, bqValue, err)
+	listValue := value.GetListValue()
+	if listValue == nil {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktListValue, bqValue)
 	}
-	return &structValue, nil
+	return listValue, nil
 }
 
-func (o *MessageLoader) unmarshalDoubleValue(bqValue bigquery.Value) (*wrapperspb.DoubleValue, error) {
-	switch bqValue := bqValue.(type) {
-	case float32:
-		return wrapperspb.Double(float64(bqValue)), nil
-	case float64:
-		return wrapperspb.Double(bqValue), nil
+// unmarshalValue loads a Value from a JSON value, as a string or as decoded by the BigQuery client.
+func (o *MessageLoader) unmarshalValue(bqValue bigquery.Value) (*structpb.Value, error) {
+	if s, ok := bqValue.(string); ok {
+		var value structpb.Value
+		if err := value.UnmarshalJSON([]byte(s)); err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid JSON value: %#v: %w", bqValue, err)
+		}
+		return &value, nil
+	}
+	value, err := structpb.NewValue(decodedJSONValue(bqValue))
+	if err != nil {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for %s: %#v", wktValue, bqValue)
+	}
+	return value, nil
+}
+
+// decodedJSONValue converts the BigQuery values of a decoded JSON value to the types supported by structpb.NewValue.
+func decodedJSONValue(bqValue bigquery.Value) any {
+	switch v := bqValue.(type) {
+	case map[string]bigquery.Value:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			result[key] = decodedJSONValue(value)
+		}
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			result[key] = decodedJSONValue(value)
+		}
+		return result
+	case []bigquery.Value:
+		result := make([]any, len(v))
+		for i, value := range v {
+			result[i] = decodedJSONValue(value)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, value := range v {
+			result[i] = decodedJSONValue(value)
+		}
+		return result
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
 	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktDoubleValue, bqValue)
+		return v
 	}
 }
 
+func (o *MessageLoader) unmarshalDoubleValue(bqValue bigquery.Value) (*wrapperspb.DoubleValue, error) {
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.DoubleKind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.Double(value.Float()), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.DoubleKind)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktDoubleValue, bqValue)
+	}
+	return wrapperspb.Double(value.Float()), nil
+}
+
 func (o *MessageLoader) unmarshalFloatValue(bqValue bigquery.Value) (*wrapperspb.FloatValue, error) {
-	switch bqValue := bqValue.(type) {
-	case float32:
-		return wrapperspb.Float(bqValue), nil
-	case float64:
-		return wrapperspb.Float(float32(bqValue)), nil
-	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktFloatValue, bqValue)
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.FloatKind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.Float(float32(value.Float())), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.FloatKind)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktFloatValue, bqValue)
 	}
+	return wrapperspb.Float(float32(value.Float())), nil
 }
 
 func (o *MessageLoader) unmarshalInt32Value(bqValue bigquery.Value) (*wrapperspb.Int32Value, error) {
-	switch bqValue := bqValue.(type) {
-	case int32:
-		return wrapperspb.Int32(bqValue), nil
-	case int64:
-		return wrapperspb.Int32(int32(bqValue)), nil
-	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktInt32Value, bqValue)
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.Int32Kind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.Int32(int32(value.Int())), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.Int32Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktInt32Value, bqValue)
 	}
+	return wrapperspb.Int32(int32(value.Int())), nil
 }
 
 func (o *MessageLoader) unmarshalInt64Value(bqValue bigquery.Value) (*wrapperspb.Int64Value, error) {
-	switch bqValue := bqValue.(type) {
-	case int32:
-		return wrapperspb.Int64(int64(bqValue)), nil
-	case int64:
-		return wrapperspb.Int64(bqValue), nil
-	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktInt64Value, bqValue)
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.Int64Kind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.Int64(value.Int()), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.Int64Kind)
+	if err != nil {
+		return nil, err
 	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktInt64Value, bqValue)
+	}
+	return wrapperspb.Int64(value.Int()), nil
 }
 
 func (o *MessageLoader) unmarshalUInt32Value(bqValue bigquery.Value) (*wrapperspb.UInt32Value, error) {
-	switch bqValue := bqValue.(type) {
-	case uint32:
-		return wrapperspb.UInt32(bqValue), nil
-	case uint64:
-		return wrapperspb.UInt32(uint32(bqValue)), nil
-	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktUInt32Value, bqValue)
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.Uint32Kind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.UInt32(uint32(value.Uint())), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.Uint32Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktUInt32Value, bqValue)
 	}
+	return wrapperspb.UInt32(uint32(value.Uint())), nil
 }
 
 func (o *MessageLoader) unmarshalUInt64Value(bqValue bigquery.Value) (*wrapperspb.UInt64Value, error) {
-	switch bqValue := bqValue.(type) {
-	case uint32:
-		return wrapperspb.UInt64(uint64(bqValue)), nil
-	case uint64:
-		return wrapperspb.UInt64(bqValue), nil
-	default:
-		return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktUInt64Value, bqValue)
+	if r, ok := bqValue.(*big.Rat); ok {
+		value, err := o.numericScalar(r, protoreflect.Uint64Kind)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.UInt64(value.Uint()), nil
+	}
+	value, ok, err := o.coerceScalar(bqValue, protoreflect.Uint64Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktUInt64Value, bqValue)
 	}
+	return wrapperspb.UInt64(value.Uint()), nil
 }
 
 func (o *MessageLoader) unmarshalBoolValue(bqValue bigquery.Value) (*wrapperspb.BoolValue, error) {
-	if bqValue, ok := bqValue.(bool); ok {
-		return wrapperspb.Bool(bqValue), nil
+	value, ok, err := o.coerceBool(bqValue)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktBoolValue, bqValue)
 	}
-	return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktBoolValue, bqValue)
+	return wrapperspb.Bool(value), nil
 }
 
 func (o *MessageLoader) unmarshalStringValue(bqValue bigquery.Value) (*wrapperspb.StringValue, error) {
-	if bqValue, ok := bqValue.(string); ok {
+	switch bqValue := bqValue.(type) {
+	case string:
 		return wrapperspb.String(bqValue), nil
+	case *big.Rat:
+		str, err := numericDecimalString(bqValue)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.String(str), nil
+	default:
+		if str, ok := o.coerceString(bqValue); ok {
+			return wrapperspb.String(str), nil
+		}
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktStringValue, bqValue)
 	}
-	return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktStringValue, bqValue)
 }
 
 func (o *MessageLoader) unmarshalBytesValue(bqValue bigquery.Value) (*wrapperspb.BytesValue, error) {
-	if bqValue, ok := bqValue.([]byte); ok {
+	switch bqValue := bqValue.(type) {
+	case []byte:
 		return wrapperspb.Bytes(bqValue), nil
+	case *big.Rat:
+		str, err := numericDecimalString(bqValue)
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.Bytes([]byte(str)), nil
+	default:
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value for %s: %#v", wktBytesValue, bqValue)
 	}
-	return nil, fmt.Errorf("invalid BigQuery value for %s: %#v", wktBytesValue, bqValue)
 }
 
 func (o *MessageLoader) unmarshalRangeField(bqValue bigquery.Value, field protoreflect.FieldDescriptor, message protoreflect.Message) (protoreflect.Value, error) {
 	rangeValue, ok := bqValue.(*bigquery.RangeValue)
 	if !ok {
-		return protoreflect.ValueOf(nil), fmt.Errorf("unsupported BigQuery value for RANGE: %T", bqValue)
+		return protoreflect.ValueOf(nil), newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for RANGE: %T", bqValue)
 	}
 	// Create a new instance of the range message type
 	fieldValue := message.NewField(field)
@@ -1002,13 +1247,15 @@ func (o *MessageLoader) unmarshalRangeField(bqValue bigquery.Value, field protor
 	startField := rangeMessage.Descriptor().Fields().ByName("start")
 	endField := rangeMessage.Descriptor().Fields().ByName("end")
 	if startField == nil || endField == nil {
-		return protoreflect.ValueOf(nil), fmt.Errorf("invalid range message type: missing start or end field in %s", messageName)
+		return protoreflect.ValueOf(nil), newLoadError(
+			ErrUnsupportedType, nil, "invalid range message type: missing start or end field in %s", messageName,
+		)
 	}
 	// Handle start value
 	if rangeValue.Start != nil {
 		startValue, err := o.unmarshalRangeValue(rangeValue.Start, startField, messageName)
 		if err != nil {
-			return protoreflect.ValueOf(nil), fmt.Errorf("error unmarshaling range start: %w", err)
+			return protoreflect.ValueOf(nil), wrapLoadError(err, "start", nil, startField)
 		}
 		if startValue.IsValid() {
 			rangeMessage.Set(startField, startValue)
@@ -1018,7 +1265,7 @@ func (o *MessageLoader) unmarshalRangeField(bqValue bigquery.Value, field protor
 	if rangeValue.End != nil {
 		endValue, err := o.unmarshalRangeValue(rangeValue.End, endField, messageName)
 		if err != nil {
-			return protoreflect.ValueOf(nil), fmt.Errorf("error unmarshaling range end: %w", err)
+			return protoreflect.ValueOf(nil), wrapLoadError(err, "end", nil, endField)
 		}
 		if endValue.IsValid() {
 			rangeMessage.Set(endField, endValue)
@@ -1041,26 +1288,30 @@ func (o *MessageLoader) unmarshalRangeValue(bqValue bigquery.Value, field protor
 			}
 			// For DATETIME ranges, format as YYYY-MM-DD HH:MM:SS[.ffffff]
 			return protoreflect.ValueOfString(v.Format("2006-01-02 15:04:05.999999")), nil
+		case civil.Date:
+			return protoreflect.ValueOfString(v.String()), nil
+		case civil.DateTime:
+			return protoreflect.ValueOfString(v.In(time.UTC).Format("2006-01-02 15:04:05.999999")), nil
 		default:
-			return protoreflect.ValueOf(nil), fmt.Errorf("unsupported value type for string range field: %T", bqValue)
+			return protoreflect.ValueOf(nil), newLoadError(ErrTypeMismatch, bqValue, "unsupported value type for string range field: %T", bqValue)
 		}
 	case protoreflect.MessageKind:
 		// For TimestampRange, create a google.protobuf.Timestamp
 		if strings.Contains(string(field.Message().FullName()), "Timestamp") {
 			switch v := bqValue.(type) {
 			case time.Time:
-				timestamp, err := o.unmarshalTimestamp(v)
+				timestamp, err := o.unmarshalTimestamp(v, field)
 				if err != nil {
 					return protoreflect.ValueOf(nil), err
 				}
 				return protoreflect.ValueOf(timestamp.ProtoReflect()), nil
 			default:
-				return protoreflect.ValueOf(nil), fmt.Errorf("unsupported value type for timestamp range field: %T", bqValue)
+				return protoreflect.ValueOf(nil), newLoadError(ErrTypeMismatch, bqValue, "unsupported value type for timestamp range field: %T", bqValue)
 			}
 		}
-		return protoreflect.ValueOf(nil), fmt.Errorf("unsupported message type for range field: %s", field.Message().FullName())
+		return protoreflect.ValueOf(nil), newLoadError(ErrUnsupportedType, bqValue, "unsupported message type for range field: %s", field.Message().FullName())
 	default:
-		return protoreflect.ValueOf(nil), fmt.Errorf("unsupported field kind for range value: %s", field.Kind())
+		return protoreflect.ValueOf(nil), newLoadError(ErrUnsupportedType, bqValue, "unsupported field kind for range value: %s", field.Kind())
 	}
 }
 
@@ -1074,6 +1325,11 @@ func (o *MessageLoader) unmarshalScalar(
 		return o.zeroValueForFieldSchema(field)
 	}
 
+	// Handle NUMERIC and BIGNUMERIC values, as returned by the BigQuery client
+	if r, ok := bqValue.(*big.Rat); ok {
+		return o.unmarshalNumeric(r, field)
+	}
+
 	// Handle special BigQuery field types that require validation
 	if bqFieldSchema != nil {
 		switch bqFieldSchema.Type {
@@ -1086,69 +1342,38 @@ func (o *MessageLoader) unmarshalScalar(
 			// For JSON fields, validate that the string is valid JSON
 			if str, ok := bqValue.(string); ok {
 				if err := o.validateJSONString(str); err != nil {
-					return protoreflect.Value{}, err
+					return protoreflect.Value{}, newLoadError(ErrInvalidValue, bqValue, "%w", err)
 				}
 			}
 		case bigquery.DateFieldType:
 			// For DATE fields with string values, validate the date format
 			if str, ok := bqValue.(string); ok {
 				if err := o.validateDateString(str); err != nil {
-					return protoreflect.Value{}, err
+					return protoreflect.Value{}, newLoadError(ErrInvalidValue, bqValue, "%w", err)
 				}
 			}
 		}
 	}
 
-	switch field.Kind() {
-	case protoreflect.BoolKind:
-		if b, ok := bqValue.(bool); ok {
-			return protoreflect.ValueOfBool(b), nil
-		}
-
-	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
-		if n, ok := bqValue.(int64); ok {
-			return protoreflect.ValueOfInt32(int32(n)), nil
-		}
+	// Handle values of scalar types, converted according to the CoercionMode
+	if value, ok, err := o.coerceScalar(bqValue, field.Kind()); ok || err != nil {
+		return value, err
+	}
 
-	case protoreflect.Int64Kind:
+	switch field.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
 		switch v := bqValue.(type) {
-		case int64:
-			return protoreflect.ValueOfInt64(v), nil
-		case time.Time:
-			return protoreflect.ValueOfInt64(v.UnixMicro()), nil
-		}
-
-	case protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-		if n, ok := bqValue.(int64); ok {
-			return protoreflect.ValueOfInt64(n), nil
-		}
-
-	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
-		if n, ok := bqValue.(int64); ok {
-			return protoreflect.ValueOfUint32(uint32(n)), nil
-		}
-
-	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-		if n, ok := bqValue.(int64); ok {
-			return protoreflect.ValueOfUint64(uint64(n)), nil
-		}
-
-	case protoreflect.FloatKind:
-		if n, ok := bqValue.(float64); ok {
-			return protoreflect.ValueOfFloat32(float32(n)), nil
-		}
-
-	case protoreflect.DoubleKind:
-		if n, ok := bqValue.(float64); ok {
-			return protoreflect.ValueOfFloat64(n), nil
+		case time.Time, civil.Date:
+			// Microseconds or days since Unix epoch by default
+			return o.unmarshalEpochInt64(v, field)
 		}
 
 	case protoreflect.StringKind:
 		switch v := bqValue.(type) {
-		case string:
-			return protoreflect.ValueOfString(v), nil
 		case time.Time:
 			return protoreflect.ValueOfString(v.Format(time.RFC3339Nano)), nil
+		case *bigquery.IntervalValue:
+			return protoreflect.ValueOfString(v.String()), nil
 		}
 
 	case protoreflect.BytesKind:
@@ -1161,7 +1386,7 @@ func (o *MessageLoader) unmarshalScalar(
 	case protoreflect.MessageKind, protoreflect.GroupKind:
 		// Fall through to return error, these should have been handled by the caller.
 	}
-	return protoreflect.Value{}, fmt.Errorf("invalid BigQuery value %#v for kind %v", bqValue, field.Kind())
+	return protoreflect.Value{}, newLoadError(ErrTypeMismatch, bqValue, "invalid BigQuery value %#v for kind %v", bqValue, field.Kind())
 }
 
 func (o *MessageLoader) unmarshalEnumScalar(
@@ -1170,24 +1395,38 @@ func (o *MessageLoader) unmarshalEnumScalar(
 ) (protoreflect.Value, error) {
 	switch v := bqValue.(type) {
 	case int64:
-		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(int32(v))), nil
+		number, _, err := o.coerceScalar(v, protoreflect.Int32Kind)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(number.Int())), nil
 	case string:
+		if v == "null" && field.Enum().FullName() == wktNullValue {
+			// JSON null, as loaded from a JSON column.
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(structpb.NullValue_NULL_VALUE)), nil
+		}
 		enumVal := field.Enum().Values().ByName(protoreflect.Name(v))
 		if enumVal == nil {
-			return protoreflect.Value{}, fmt.Errorf(
-				"unknown enum value %#v for enum %s", bqValue, field.Enum().FullName(),
+			return protoreflect.Value{}, newLoadError(
+				ErrInvalidValue, bqValue, "unknown enum value %#v for enum %s", bqValue, field.Enum().FullName(),
 			)
 		}
 		return protoreflect.ValueOfEnum(enumVal.Number()), nil
 	default:
-		return protoreflect.Value{}, fmt.Errorf("invalid BigQuery value %#v for enum %s", bqValue, field.Enum().FullName())
+		return protoreflect.Value{}, newLoadError(
+			ErrTypeMismatch, bqValue, "invalid BigQuery value %#v for enum %s", bqValue, field.Enum().FullName(),
+		)
 	}
 }
 
 const (
+	wktAny         = "google.protobuf.Any"
 	wktTimestamp   = "google.protobuf.Timestamp"
 	wktDuration    = "google.protobuf.Duration"
 	wktStruct      = "google.protobuf.Struct"
+	wktValue       = "google.protobuf.Value"
+	wktListValue   = "google.protobuf.ListValue"
+	wktNullValue   = "google.protobuf.NullValue"
 	wktTimeOfDay   = "google.type.TimeOfDay"
 	wktDate        = "google.type.Date"
 	kwtDateTime    = "google.type.DateTime"
@@ -1208,6 +1447,8 @@ func isWellKnownType(t string) bool {
 	case wktTimestamp,
 		wktDuration,
 		wktStruct,
+		wktValue,
+		wktListValue,
 		wktTimeOfDay,
 		wktDate,
 		kwtDateTime,
@@ -1227,6 +1468,21 @@ func isWellKnownType(t string) bool {
 	}
 }
 
+// isLoadedAsWellKnownType reports whether the loader loads messages of the type from columns of the
+// BigQuery type as a well-known type.
+// Unlike other well-known types, google.protobuf.Any is also loaded from a RECORD of its fields,
+// and decimal types are only loaded as well-known types from NUMERIC and BIGNUMERIC columns.
+func isLoadedAsWellKnownType(t protoreflect.FullName, bqType bigquery.FieldType) bool {
+	switch {
+	case t == wktAny:
+		return true
+	case isDecimalType(t):
+		return isNumericFieldType(bqType)
+	default:
+		return isWellKnownType(string(t))
+	}
+}
+
 // zeroValueForFieldSchema returns the zero value for a protobuf field based on its kind
 func (o *MessageLoader) zeroValueForFieldSchema(field protoreflect.FieldDescriptor) (protoreflect.Value, error) {
 	switch field.Kind() {
@@ -1272,165 +1528,32 @@ func (o *MessageLoader) validateDateString(str string) error {
 	return nil
 }
 
-// parseNumericString parses a NUMERIC or BIGNUMERIC string value into the appropriate protobuf type
+// parseNumericString parses a NUMERIC or BIGNUMERIC string value into the appropriate protobuf type.
+// Values are converted without silently losing precision, see numericScalar.
 func (o *MessageLoader) parseNumericString(str string, field protoreflect.FieldDescriptor) (protoreflect.Value, error) {
 	switch field.Kind() {
-	case protoreflect.DoubleKind:
-		if f, err := strconv.ParseFloat(str, 64); err == nil {
-			return protoreflect.ValueOfFloat64(f), nil
-		}
-	case protoreflect.FloatKind:
-		if f, err := strconv.ParseFloat(str, 32); err == nil {
-			return protoreflect.ValueOfFloat32(float32(f)), nil
-		}
-	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
-		if i, err := strconv.ParseInt(str, 10, 32); err == nil {
-			return protoreflect.ValueOfInt32(int32(i)), nil
-		}
-	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-		if i, err := strconv.ParseInt(str, 10, 64); err == nil {
-			return protoreflect.ValueOfInt64(i), nil
-		}
-	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
-		if u, err := strconv.ParseUint(str, 10, 32); err == nil {
-			return protoreflect.ValueOfUint32(uint32(u)), nil
-		}
-	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-		if u, err := strconv.ParseUint(str, 10, 64); err == nil {
-			return protoreflect.ValueOfUint64(u), nil
-		}
 	case protoreflect.StringKind:
 		// For string fields, just pass through the numeric string
 		return protoreflect.ValueOfString(str), nil
 	case protoreflect.BytesKind:
 		// For bytes fields, return the string as bytes
 		return protoreflect.ValueOfBytes([]byte(str)), nil
+	case protoreflect.BoolKind, protoreflect.EnumKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoreflect.Value{}, newLoadError(
+			ErrTypeMismatch, str, "cannot convert NUMERIC string %q to protobuf kind %v", str, field.Kind(),
+		)
 	}
-	return protoreflect.Value{}, fmt.Errorf("cannot convert NUMERIC string %q to protobuf kind %v", str, field.Kind())
-}
-
-// parseBigQueryInterval parses BigQuery interval format (H:MM:SS or H:MM:SS.sss)
-func parseBigQueryInterval(s string) (time.Duration, error) {
-	// Split by colons
-	parts := strings.Split(s, ":")
-	if len(parts) != 3 {
-		return 0, fmt.Errorf("invalid BigQuery interval format: expected H:MM:SS")
-	}
-	// Parse hours
-	hours, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("invalid hours in BigQuery interval: %s", parts[0])
+	r, ok := parseNumeric(str)
+	if !ok {
+		return protoreflect.Value{}, newLoadError(
+			ErrInvalidValue, str, "cannot convert NUMERIC string %q to protobuf kind %v: invalid number", str, field.Kind(),
+		)
 	}
-	// Parse minutes
-	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	value, err := o.numericScalar(r, field.Kind())
 	if err != nil {
-		return 0, fmt.Errorf("invalid minutes in BigQuery interval: %s", parts[1])
-	}
-	// Parse seconds (may include fractional part)
-	var seconds int64
-	var nanoseconds int64
-	secPart := parts[2]
-	if dotIdx := strings.Index(secPart, "."); dotIdx >= 0 {
-		// Handle fractional seconds
-		if sec, err := strconv.ParseInt(secPart[:dotIdx], 10, 64); err == nil {
-			seconds = sec
-		} else {
-			return 0, fmt.Errorf("invalid seconds in BigQuery interval: %s", secPart[:dotIdx])
-		}
-		fracPart := secPart[dotIdx+1:]
-		if len(fracPart) > 0 {
-			// Convert fractional part to nanoseconds
-			for len(fracPart) < 9 {
-				fracPart += "0"
-			}
-			if len(fracPart) > 9 {
-				fracPart = fracPart[:9]
-			}
-			if nanos, err := strconv.ParseInt(fracPart, 10, 64); err == nil {
-				nanoseconds = nanos
-			} else {
-				return 0, fmt.Errorf("invalid fractional seconds in BigQuery interval: %s", fracPart)
-			}
-		}
-	} else {
-		if sec, err := strconv.ParseInt(secPart, 10, 64); err == nil {
-			seconds = sec
-		} else {
-			return 0, fmt.Errorf("invalid seconds in BigQuery interval: %s", secPart)
-		}
-	}
-	duration := time.Duration(hours)*time.Hour +
-		time.Duration(minutes)*time.Minute +
-		time.Duration(seconds)*time.Second +
-		time.Duration(nanoseconds)*time.Nanosecond
-	return duration, nil
-}
-
-// parseISO8601Duration parses ISO8601 duration format (PT1H30M45.123S)
-func parseISO8601Duration(s string) (time.Duration, error) {
-	// Basic ISO8601 parser for common cases
-	// Format: PT[nH][nM][n[.n]S]
-	if !strings.HasPrefix(s, "PT") {
-		return 0, fmt.Errorf("invalid ISO8601 duration: must start with PT")
-	}
-	s = s[2:] // Remove "PT" prefix
-	var hours, minutes, seconds, nanoseconds int64
-	// Parse hours
-	if idx := strings.Index(s, "H"); idx >= 0 {
-		if h, err := strconv.ParseInt(s[:idx], 10, 64); err == nil {
-			hours = h
-			s = s[idx+1:]
-		} else {
-			return 0, fmt.Errorf("invalid hours in ISO8601 duration: %s", s[:idx])
-		}
-	}
-	// Parse minutes
-	if idx := strings.Index(s, "M"); idx >= 0 {
-		if m, err := strconv.ParseInt(s[:idx], 10, 64); err == nil {
-			minutes = m
-			s = s[idx+1:]
-		} else {
-			return 0, fmt.Errorf("invalid minutes in ISO8601 duration: %s", s[:idx])
-		}
-	}
-	// Parse seconds
-	if idx := strings.Index(s, "S"); idx >= 0 {
-		secStr := s[:idx]
-		if dotIdx := strings.Index(secStr, "."); dotIdx >= 0 {
-			// Handle fractional seconds
-			secPart := secStr[:dotIdx]
-			fracPart := secStr[dotIdx+1:]
-			if sec, err := strconv.ParseInt(secPart, 10, 64); err == nil {
-				seconds = sec
-			} else {
-				return 0, fmt.Errorf("invalid seconds in ISO8601 duration: %s", secPart)
-			}
-			// Convert fractional part to nanoseconds
-			if len(fracPart) > 0 {
-				// Pad or truncate to 9 digits (nanoseconds)
-				for len(fracPart) < 9 {
-					fracPart += "0"
-				}
-				if len(fracPart) > 9 {
-					fracPart = fracPart[:9]
-				}
-				if nanos, err := strconv.ParseInt(fracPart, 10, 64); err == nil {
-					nanoseconds = nanos
-				} else {
-					return 0, fmt.Errorf("invalid fractional seconds in ISO8601 duration: %s", fracPart)
-				}
-			}
-		} else {
-			if sec, err := strconv.ParseInt(secStr, 10, 64); err == nil {
-				seconds = sec
-			} else {
-				return 0, fmt.Errorf("invalid seconds in ISO8601 duration: %s", secStr)
-			}
-		}
+		err.Value = str
+		err.Err = fmt.Errorf("cannot convert NUMERIC string %q to protobuf kind %v: %w", str, field.Kind(), err.Err)
+		return protoreflect.Value{}, err
 	}
-	duration := time.Duration(hours)*time.Hour +
-		time.Duration(minutes)*time.Minute +
-		time.Duration(seconds)*time.Second +
-		time.Duration(nanoseconds)*time.Nanosecond
-	return duration, nil
+	return value, nil
 }
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -1310,7 +1311,7 @@ func TestMessageLoader(t *testing.T) {
 				},
 
 				{
-					name: "LatLng from GeoJSON POINT (should fail - not supported yet)",
+					name: "LatLng from GeoJSON POINT",
 					messageLoader: MessageLoader{
 						Message: &testdatav1.KitchenSink{},
 					},
@@ -1322,9 +1323,12 @@ func TestMessageLoader(t *testing.T) {
 					},
 					expected: func() proto.Message {
 						result := &testdatav1.KitchenSink{}
+						result.SetLatlngValue(&latlng.LatLng{
+							Latitude:  37.7749,
+							Longitude: -122.4194,
+						})
 						return result
 					},
-					expectedError: "invalid GEOGRAPHY value", // Should fail because GeoJSON parsing not implemented
 				},
 
 				{
@@ -2609,7 +2613,7 @@ func TestMessageLoader(t *testing.T) {
 				},
 
 				{
-					name: "google.type.DateTime from time.Time (with timezone)",
+					name: "google.type.DateTime from time.Time (with UTC offset)",
 					messageLoader: MessageLoader{
 						Message: &testdatav1.KitchenSink{},
 					},
@@ -2629,10 +2633,8 @@ func TestMessageLoader(t *testing.T) {
 							Minutes: 30,
 							Seconds: 45,
 							Nanos:   123456000,
-							TimeOffset: &datetime.DateTime_TimeZone{
-								TimeZone: &datetime.TimeZone{
-									Id: "-08:00",
-								},
+							TimeOffset: &datetime.DateTime_UtcOffset{
+								UtcOffset: durationpb.New(-8 * time.Hour),
 							},
 						})
 						return result
@@ -3378,6 +3380,228 @@ func TestMessageLoader(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "json_types",
+			testCases: []testCase{
+				{
+					name: "JSON string to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						`{"a":[1,"b",null]}`,
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "json_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetJsonValue(structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+							"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+								structpb.NewNumberValue(1), structpb.NewStringValue("b"), structpb.NewNullValue(),
+							}}),
+						}}))
+						return &result
+					},
+				},
+
+				{
+					name: "decoded JSON object to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						map[string]bigquery.Value{"a": []bigquery.Value{float64(1), "b"}},
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "json_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetJsonValue(structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+							"a": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+								structpb.NewNumberValue(1), structpb.NewStringValue("b"),
+							}}),
+						}}))
+						return &result
+					},
+				},
+
+				{
+					name: "JSON scalar to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						"true",
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "json_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetJsonValue(structpb.NewBoolValue(true))
+						return &result
+					},
+				},
+
+				{
+					name: "JSON string to ListValue",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						`[1,"a"]`,
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "list_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetListValue(&structpb.ListValue{Values: []*structpb.Value{
+							structpb.NewNumberValue(1), structpb.NewStringValue("a"),
+						}})
+						return &result
+					},
+				},
+
+				{
+					name: "decoded JSON array to ListValue",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						[]any{float64(1), "a"},
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "list_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetListValue(&structpb.ListValue{Values: []*structpb.Value{
+							structpb.NewNumberValue(1), structpb.NewStringValue("a"),
+						}})
+						return &result
+					},
+				},
+
+				{
+					name: "decoded JSON object to Struct",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						map[string]any{"a": "b"},
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "struct_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+							"a": structpb.NewStringValue("b"),
+						}})
+						return &result
+					},
+				},
+
+				{
+					name: "JSON null to NullValue",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						"null",
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "null_value", Type: bigquery.JSONFieldType},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetNullValue(structpb.NullValue_NULL_VALUE)
+						return &result
+					},
+				},
+
+				{
+					name: "repeated JSON to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						[]bigquery.Value{`"a"`, `2`},
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "repeated_value", Type: bigquery.JSONFieldType, Repeated: true},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetRepeatedValue([]*structpb.Value{structpb.NewStringValue("a"), structpb.NewNumberValue(2)})
+						return &result
+					},
+				},
+
+				{
+					name: "map with JSON values to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						[]bigquery.Value{
+							[]bigquery.Value{"k", `{"x":1}`},
+						},
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{
+							Name:     "map_string_value",
+							Type:     bigquery.RecordFieldType,
+							Repeated: true,
+							Schema: bigquery.Schema{
+								{Name: "key", Type: bigquery.StringFieldType},
+								{Name: "value", Type: bigquery.JSONFieldType},
+							},
+						},
+					},
+					expected: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetMapStringValue(map[string]*structpb.Value{
+							"k": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+								"x": structpb.NewNumberValue(1),
+							}}),
+						})
+						return &result
+					},
+				},
+
+				{
+					name: "JSON array to Struct",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						`[1]`,
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "struct_value", Type: bigquery.JSONFieldType},
+					},
+					expectedError: "unsupported BigQuery value for google.protobuf.Struct",
+				},
+
+				{
+					name: "invalid JSON to Value",
+					messageLoader: MessageLoader{
+						Message: &testdatav1.KitchenSink{},
+					},
+					row: []bigquery.Value{
+						`{"a":`,
+					},
+					schema: bigquery.Schema{
+						&bigquery.FieldSchema{Name: "json_value", Type: bigquery.JSONFieldType},
+					},
+					expectedError: "invalid JSON value",
+				},
+			},
+		},
 	}
 	for _, testCaseCategory := range testCaseCategories {
 		t.Run(testCaseCategory.name, func(t *testing.T) {
@@ -3402,6 +3626,65 @@ func TestMessageLoader(t *testing.T) {
 	}
 }
 
+func BenchmarkMessageLoader_Load(b *testing.B) {
+	schema := bigquery.Schema{
+		{Name: "double_value", Type: bigquery.FloatFieldType},
+		{Name: "int64_value", Type: bigquery.IntegerFieldType},
+		{Name: "bool_value", Type: bigquery.BooleanFieldType},
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "enum_value", Type: bigquery.StringFieldType},
+		{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+		{
+			Name: "nested_message",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "text", Type: bigquery.StringFieldType},
+				{Name: "number", Type: bigquery.IntegerFieldType},
+				{Name: "string_option", Type: bigquery.StringFieldType},
+			},
+		},
+		{
+			Name:     "map_string_int32",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.IntegerFieldType},
+			},
+		},
+		{Name: "timestamp_value", Type: bigquery.TimestampFieldType},
+		{Name: "int64_wrapper_value", Type: bigquery.IntegerFieldType},
+	}
+	row := []bigquery.Value{
+		1.5,
+		int64(42),
+		true,
+		"hello",
+		"TEST_ENUM_VALUE_ONE",
+		[]bigquery.Value{"a", "b", "c"},
+		[]bigquery.Value{"nested", int64(7), "option"},
+		[]bigquery.Value{[]bigquery.Value{"k", int64(3)}},
+		time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC),
+		int64(43),
+	}
+	b.Run("cached plan", func(b *testing.B) {
+		loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+		for b.Loop() {
+			if err := loader.Load(row, schema); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("plan per row", func(b *testing.B) {
+		for b.Loop() {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+			if err := loader.Load(row, schema); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 // mustParseTime is a helper function for test cases
 func mustParseTime(timeStr string) time.Time {
 	t, err := time.Parse(time.RFC3339Nano, timeStr)
@@ -0,0 +1,417 @@
+package protobq
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/fraction"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MessageSaver implements bigquery.ValueSaver for a proto.Message.
+// The message is converted to a BigQuery row using the inverse of the type mappings
+// understood by MessageLoader.
+type MessageSaver struct {
+	// InsertID is used for best-effort de-duplication of inserted rows.
+	// If empty, the BigQuery client generates a random insert ID.
+	InsertID string
+
+	// Message to save.
+	Message proto.Message
+}
+
+var _ bigquery.ValueSaver = &MessageSaver{}
+
+// Save the given proto.Message as a BigQuery row.
+// Only populated fields are included in the row, unpopulated fields are written as NULL.
+// Columns are named after the field option (protobq.v1.field).column, or the proto name of the field.
+// Unsigned integers are written as INTEGER values, and fail with ErrOutOfRange above math.MaxInt64.
+// google.type.DateTime fields are written as DATETIME values of their local date and time,
+// without their UTC offset or time zone.
+// google.type.Decimal, google.type.Money and google.type.Fraction fields are written as *big.Rat
+// NUMERIC values, and the currency code of google.type.Money fields to the STRING column set by
+// the field option (protobq.v1.field).currency_column.
+// Errors are of type *LoadError, carrying the path of the offending column.
+func (s *MessageSaver) Save() (map[string]bigquery.Value, string, error) {
+	row, err := s.saveMessage(s.Message.ProtoReflect())
+	if err != nil {
+		return nil, "", err
+	}
+	return row, s.InsertID, nil
+}
+
+func (s *MessageSaver) saveMessage(message protoreflect.Message) (map[string]bigquery.Value, error) {
+	row := make(map[string]bigquery.Value, message.Descriptor().Fields().Len())
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		var bqValue bigquery.Value
+		switch {
+		case field.IsList():
+			bqValue, err = s.saveListField(value.List(), field)
+		case field.IsMap():
+			bqValue, err = s.saveMapField(value.Map(), field)
+		default:
+			bqValue, err = s.saveSingularField(value, field)
+		}
+		if err != nil {
+			err = wrapLoadError(err, columnName(field), nil, field)
+			return false
+		}
+		row[columnName(field)] = bqValue
+		if currencyColumn, ok := currencyColumnOption(field); ok {
+			row[currencyColumn] = value.Message().Interface().(*money.Money).GetCurrencyCode()
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (s *MessageSaver) saveListField(
+	list protoreflect.List,
+	field protoreflect.FieldDescriptor,
+) ([]bigquery.Value, error) {
+	result := make([]bigquery.Value, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		bqValue, err := s.saveSingularField(list.Get(i), field)
+		if err != nil {
+			return nil, wrapLoadError(err, indexSegment(i), nil, field)
+		}
+		result = append(result, bqValue)
+	}
+	return result, nil
+}
+
+func (s *MessageSaver) saveMapField(
+	mapValue protoreflect.Map,
+	field protoreflect.FieldDescriptor,
+) ([]bigquery.Value, error) {
+	result := make([]bigquery.Value, 0, mapValue.Len())
+	var err error
+	mapValue.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		var bqKey, bqValue bigquery.Value
+		if bqKey, err = s.saveScalar(key.Value(), field.MapKey()); err != nil {
+			bqMapEntry := map[string]bigquery.Value{"key": key.Interface()}
+			err = wrapLoadError(err, mapEntrySegment(len(result), bqMapEntry)+".key", nil, field.MapKey())
+			return false
+		}
+		bqMapEntry := map[string]bigquery.Value{"key": bqKey}
+		if bqValue, err = s.saveSingularField(value, field.MapValue()); err != nil {
+			err = wrapLoadError(err, mapEntrySegment(len(result), bqMapEntry)+".value", nil, field.MapValue())
+			return false
+		}
+		bqMapEntry["value"] = bqValue
+		result = append(result, bqMapEntry)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *MessageSaver) saveSingularField(
+	value protoreflect.Value,
+	field protoreflect.FieldDescriptor,
+) (bigquery.Value, error) {
+	if field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		switch {
+		case isWellKnownType(string(field.Message().FullName())):
+			return s.saveWellKnownType(value.Message().Interface())
+		case isDecimalType(field.Message().FullName()):
+			return s.saveDecimal(value.Message().Interface(), field)
+		case isRangeMessage(field.Message()):
+			return s.saveRange(value.Message())
+		default:
+			return s.saveMessage(value.Message())
+		}
+	}
+	return s.saveScalar(value, field)
+}
+
+func (s *MessageSaver) saveScalar(
+	value protoreflect.Value,
+	field protoreflect.FieldDescriptor,
+) (bigquery.Value, error) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool(), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return value.Int(), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return saveUint64(value.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float(), nil
+	case protoreflect.StringKind:
+		return value.String(), nil
+	case protoreflect.BytesKind:
+		return value.Bytes(), nil
+	case protoreflect.EnumKind:
+		return int64(value.Enum()), nil
+	default:
+		return nil, newLoadError(ErrUnsupportedType, nil, "unsupported kind: %v", field.Kind())
+	}
+}
+
+// saveUint64 saves an unsigned integer as an INTEGER value, or fails with ErrOutOfRange if it overflows int64.
+func saveUint64(value uint64) (bigquery.Value, error) {
+	if value > math.MaxInt64 {
+		return nil, newLoadError(ErrOutOfRange, value, "%d overflows INTEGER", value)
+	}
+	return int64(value), nil
+}
+
+// saveDecimal saves a google.type.Decimal, google.type.Money or google.type.Fraction as a NUMERIC value,
+// the inverse of loading them. Values without a finite decimal representation, such as the fraction 1/3,
+// fail with ErrPrecisionLoss. The currency code of a google.type.Money field with the field option
+// (protobq.v1.field).currency_code must be empty or match the option.
+func (s *MessageSaver) saveDecimal(message proto.Message, field protoreflect.FieldDescriptor) (bigquery.Value, error) {
+	var result *big.Rat
+	switch message := message.(type) {
+	case *decimal.Decimal:
+		r, ok := parseNumeric(message.GetValue())
+		if !ok {
+			return nil, newLoadError(ErrInvalidValue, message.GetValue(), "invalid %s: %q", wktDecimal, message.GetValue())
+		}
+		result = r
+	case *money.Money:
+		units, nanos := message.GetUnits(), message.GetNanos()
+		if nanos <= -1e9 || nanos >= 1e9 || units > 0 && nanos < 0 || units < 0 && nanos > 0 {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: units %d and nanos %d", wktMoney, units, nanos)
+		}
+		if fieldOptions := fieldOptions(field); fieldOptions.HasCurrencyCode() && message.GetCurrencyCode() != "" &&
+			message.GetCurrencyCode() != fieldOptions.GetCurrencyCode() {
+			return nil, newLoadError(
+				ErrInvalidValue, message.GetCurrencyCode(), "currency code %s does not match the field currency %s",
+				message.GetCurrencyCode(), fieldOptions.GetCurrencyCode(),
+			)
+		}
+		result = new(big.Rat).Add(new(big.Rat).SetInt64(units), big.NewRat(int64(nanos), 1e9))
+	case *fraction.Fraction:
+		if message.GetDenominator() == 0 {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: zero denominator", wktFraction)
+		}
+		result = big.NewRat(message.GetNumerator(), message.GetDenominator())
+	default:
+		return nil, newLoadError(
+			ErrUnsupportedType, nil, "unsupported message type for %s", message.ProtoReflect().Descriptor().FullName(),
+		)
+	}
+	if _, err := numericDecimalString(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *MessageSaver) saveWellKnownType(message proto.Message) (bigquery.Value, error) {
+	switch message := message.(type) {
+	case *timestamppb.Timestamp:
+		return message.AsTime(), nil
+	case *durationpb.Duration:
+		if err := message.CheckValid(); err != nil {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: %w", wktDuration, err)
+		}
+		return formatISO8601Duration(message.GetSeconds(), message.GetNanos()), nil
+	case *timeofday.TimeOfDay:
+		return civil.Time{
+			Hour:       int(message.GetHours()),
+			Minute:     int(message.GetMinutes()),
+			Second:     int(message.GetSeconds()),
+			Nanosecond: int(message.GetNanos()),
+		}, nil
+	case *date.Date:
+		return civil.Date{
+			Year:  int(message.GetYear()),
+			Month: time.Month(message.GetMonth()),
+			Day:   int(message.GetDay()),
+		}, nil
+	case *datetime.DateTime:
+		return s.saveDateTime(message)
+	case *latlng.LatLng:
+		return fmt.Sprintf(
+			"POINT(%s %s)",
+			strconv.FormatFloat(message.GetLongitude(), 'f', -1, 64),
+			strconv.FormatFloat(message.GetLatitude(), 'f', -1, 64),
+		), nil
+	case *structpb.Struct:
+		data, err := message.MarshalJSON()
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: %w", wktStruct, err)
+		}
+		return string(data), nil
+	case *structpb.Value:
+		data, err := message.MarshalJSON()
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: %w", wktValue, err)
+		}
+		return string(data), nil
+	case *structpb.ListValue:
+		data, err := message.MarshalJSON()
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: %w", wktListValue, err)
+		}
+		return string(data), nil
+	case *wrapperspb.DoubleValue:
+		return message.GetValue(), nil
+	case *wrapperspb.FloatValue:
+		return float64(message.GetValue()), nil
+	case *wrapperspb.Int32Value:
+		return int64(message.GetValue()), nil
+	case *wrapperspb.Int64Value:
+		return message.GetValue(), nil
+	case *wrapperspb.UInt32Value:
+		return saveUint64(uint64(message.GetValue()))
+	case *wrapperspb.UInt64Value:
+		return saveUint64(message.GetValue())
+	case *wrapperspb.BoolValue:
+		return message.GetValue(), nil
+	case *wrapperspb.StringValue:
+		return message.GetValue(), nil
+	case *wrapperspb.BytesValue:
+		return message.GetValue(), nil
+	default:
+		return nil, newLoadError(
+			ErrUnsupportedType, nil, "unsupported well-known-type: %s", message.ProtoReflect().Descriptor().FullName(),
+		)
+	}
+}
+
+// saveDateTime saves a DateTime as a DATETIME value of its local date and time.
+// DATETIME values have no time offset, so the UTC offset or time zone is validated and dropped:
+// load the column with MessageLoader.TimeZone set to the same time zone to restore it.
+func (s *MessageSaver) saveDateTime(dateTime *datetime.DateTime) (bigquery.Value, error) {
+	if timeZone, ok := dateTime.GetTimeOffset().(*datetime.DateTime_TimeZone); ok {
+		if _, err := parseTimeZone(timeZone.TimeZone.GetId()); err != nil {
+			return nil, newLoadError(ErrInvalidValue, nil, "invalid %s: %w", kwtDateTime, err)
+		}
+	}
+	return civil.DateTime{
+		Date: civil.Date{
+			Year:  int(dateTime.GetYear()),
+			Month: time.Month(dateTime.GetMonth()),
+			Day:   int(dateTime.GetDay()),
+		},
+		Time: civil.Time{
+			Hour:       int(dateTime.GetHours()),
+			Minute:     int(dateTime.GetMinutes()),
+			Second:     int(dateTime.GetSeconds()),
+			Nanosecond: int(dateTime.GetNanos()),
+		},
+	}, nil
+}
+
+func (s *MessageSaver) saveRange(message protoreflect.Message) (*bigquery.RangeValue, error) {
+	var result bigquery.RangeValue
+	fields := message.Descriptor().Fields()
+	for _, bound := range []struct {
+		name  protoreflect.Name
+		value *bigquery.Value
+	}{
+		{name: "start", value: &result.Start},
+		{name: "end", value: &result.End},
+	} {
+		field := fields.ByName(bound.name)
+		if !message.Has(field) {
+			continue
+		}
+		value := message.Get(field)
+		if field.Kind() == protoreflect.MessageKind {
+			timestamp, ok := value.Message().Interface().(*timestamppb.Timestamp)
+			if !ok {
+				return nil, newLoadError(
+					ErrUnsupportedType, nil, "unsupported message type for range field: %s", field.Message().FullName(),
+				)
+			}
+			*bound.value = timestamp.AsTime()
+			continue
+		}
+		*bound.value = value.String()
+	}
+	return &result, nil
+}
+
+// isRangeMessage reports whether the message has the shape of a BigQuery RANGE<T>:
+// exactly two fields named start and end, both strings or both google.protobuf.Timestamp.
+func isRangeMessage(message protoreflect.MessageDescriptor) bool {
+	fields := message.Fields()
+	if fields.Len() != 2 {
+		return false
+	}
+	start, end := fields.ByName("start"), fields.ByName("end")
+	if start == nil || end == nil || start.Cardinality() == protoreflect.Repeated || end.Cardinality() == protoreflect.Repeated {
+		return false
+	}
+	switch {
+	case start.Kind() == protoreflect.StringKind && end.Kind() == protoreflect.StringKind:
+		return true
+	case start.Kind() == protoreflect.MessageKind && end.Kind() == protoreflect.MessageKind:
+		return start.Message().FullName() == wktTimestamp && end.Message().FullName() == wktTimestamp
+	default:
+		return false
+	}
+}
+
+// parseTimeZone parses an IANA time zone ID or a numeric UTC offset (e.g. "+08:00").
+func parseTimeZone(id string) (*time.Location, error) {
+	if strings.HasPrefix(id, "+") || strings.HasPrefix(id, "-") {
+		t, err := time.Parse("-07:00", id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone offset: %s", id)
+		}
+		_, offset := t.Zone()
+		return time.FixedZone("", offset), nil
+	}
+	return time.LoadLocation(id)
+}
+
+// formatISO8601Duration formats the seconds and nanoseconds of a duration in ISO8601 format (PT1H30M45.123S).
+// The parts are computed from the seconds, to not overflow time.Duration for durations of more than 292 years.
+func formatISO8601Duration(seconds int64, nanos int32) string {
+	var b strings.Builder
+	if seconds < 0 || nanos < 0 {
+		b.WriteByte('-')
+		seconds, nanos = -seconds, -nanos
+	}
+	b.WriteString("PT")
+	start := b.Len()
+	if hours := seconds / secondsPerHour; hours > 0 {
+		b.WriteString(strconv.FormatInt(hours, 10))
+		b.WriteByte('H')
+		seconds -= hours * secondsPerHour
+	}
+	if minutes := seconds / secondsPerMinute; minutes > 0 {
+		b.WriteString(strconv.FormatInt(minutes, 10))
+		b.WriteByte('M')
+		seconds -= minutes * secondsPerMinute
+	}
+	if seconds > 0 || nanos > 0 || b.Len() == start {
+		b.WriteString(strconv.FormatInt(seconds, 10))
+		if nanos > 0 {
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
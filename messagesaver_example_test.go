@@ -0,0 +1,32 @@
+package protobq_test
+
+import (
+	"context"
+	"os"
+
+	"cloud.google.com/go/bigquery"
+	protobq "github.com/way-platform/protobq-go"
+	"google.golang.org/genproto/googleapis/example/library/v1"
+)
+
+func ExampleMessageSaver() {
+	// 1. Connect to BigQuery.
+	ctx := context.Background()
+	client, err := bigquery.NewClient(ctx, os.Getenv("GOOGLE_CLOUD_PROJECT"))
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+	// 2. Create a message.
+	book := library.Book{
+		Author: "George Orwell",
+		Title:  "1984",
+	}
+	// 3. Insert the message into a table.
+	inserter := client.Dataset("library").Table("books").Inserter()
+	if err := inserter.Put(ctx, &protobq.MessageSaver{
+		Message: &book,
+	}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,705 @@
+package protobq
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/datetime"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/fraction"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMessageSaver(t *testing.T) {
+	type testCase struct {
+		name              string
+		message           func() proto.Message
+		expected          map[string]bigquery.Value
+		expectedError     string
+		expectedErrorKind error
+	}
+	type testCaseCategory struct {
+		name      string
+		testCases []testCase
+	}
+	testCaseCategories := []testCaseCategory{
+		{
+			name: "basic_functionality",
+			testCases: []testCase{
+				{
+					name: "empty message",
+					message: func() proto.Message {
+						return &testdatav1.KitchenSink{}
+					},
+					expected: map[string]bigquery.Value{},
+				},
+
+				{
+					name: "all primitive types",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDoubleValue(3.14159)
+						result.SetFloatValue(2.5)
+						result.SetInt32Value(42)
+						result.SetInt64Value(1234567890)
+						result.SetSint32Value(-123)
+						result.SetSint64Value(-987654321)
+						result.SetUint32Value(100)
+						result.SetUint64Value(math.MaxInt64)
+						result.SetFixed32Value(200)
+						result.SetFixed64Value(1111111111)
+						result.SetSfixed32Value(-50)
+						result.SetSfixed64Value(-2222222222)
+						result.SetBoolValue(true)
+						result.SetStringValue("hello world")
+						result.SetBytesValue([]byte("binary data"))
+						result.SetEnumValue(testdatav1.TestEnum_TEST_ENUM_VALUE_ONE)
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"double_value":   3.14159,
+						"float_value":    2.5,
+						"int32_value":    int64(42),
+						"int64_value":    int64(1234567890),
+						"sint32_value":   int64(-123),
+						"sint64_value":   int64(-987654321),
+						"uint32_value":   int64(100),
+						"uint64_value":   int64(math.MaxInt64),
+						"fixed32_value":  int64(200),
+						"fixed64_value":  int64(1111111111),
+						"sfixed32_value": int64(-50),
+						"sfixed64_value": int64(-2222222222),
+						"bool_value":     true,
+						"string_value":   "hello world",
+						"bytes_value":    []byte("binary data"),
+						"enum_value":     int64(1),
+					},
+				},
+
+				{
+					name: "nested and repeated messages",
+					message: func() proto.Message {
+						var nested testdatav1.NestedMessage
+						nested.SetText("nested")
+						nested.SetNumber(7)
+						nested.SetTags([]string{"a", "b"})
+						var element testdatav1.NestedMessage
+						element.SetFlag(true)
+						var result testdatav1.KitchenSink
+						result.SetNestedMessage(&nested)
+						result.SetRepeatedNested([]*testdatav1.NestedMessage{&element})
+						result.SetRepeatedInt32([]int32{1, 2, 3})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"nested_message": map[string]bigquery.Value{
+							"text":   "nested",
+							"number": int64(7),
+							"tags":   []bigquery.Value{"a", "b"},
+						},
+						"repeated_nested": []bigquery.Value{
+							map[string]bigquery.Value{"flag": true},
+						},
+						"repeated_int32": []bigquery.Value{int64(1), int64(2), int64(3)},
+					},
+				},
+			},
+		},
+
+		{
+			name: "maps",
+			testCases: []testCase{
+				{
+					name: "scalar and message values",
+					message: func() proto.Message {
+						var nested testdatav1.NestedMessage
+						nested.SetText("value")
+						var result testdatav1.KitchenSink
+						result.SetMapInt32String(map[int32]string{1: "one"})
+						result.SetMapStringNested(map[string]*testdatav1.NestedMessage{"a": &nested})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"map_int32_string": []bigquery.Value{
+							map[string]bigquery.Value{"key": int64(1), "value": "one"},
+						},
+						"map_string_nested": []bigquery.Value{
+							map[string]bigquery.Value{
+								"key":   "a",
+								"value": map[string]bigquery.Value{"text": "value"},
+							},
+						},
+					},
+				},
+
+				{
+					name: "well-known type values",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetMapStringInt32Wrapper(map[string]*wrapperspb.Int32Value{"x": wrapperspb.Int32(5)})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"map_string_int32_wrapper": []bigquery.Value{
+							map[string]bigquery.Value{"key": "x", "value": int64(5)},
+						},
+					},
+				},
+			},
+		},
+
+		{
+			name: "well_known_types",
+			testCases: []testCase{
+				{
+					name: "timestamp, duration, date, time of day",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetTimestampValue(timestamppb.New(mustParseTime("2023-12-25T15:30:45.123456Z")))
+						result.SetDurationValue(durationpb.New(time.Hour + 30*time.Minute + 45*time.Second + 123*time.Millisecond))
+						result.SetDateValue(&date.Date{Year: 2023, Month: 12, Day: 25})
+						result.SetTimeofdayValue(&timeofday.TimeOfDay{Hours: 15, Minutes: 30, Seconds: 45, Nanos: 500})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"timestamp_value": mustParseTime("2023-12-25T15:30:45.123456Z"),
+						"duration_value":  "PT1H30M45.123S",
+						"date_value":      civil.Date{Year: 2023, Month: time.December, Day: 25},
+						"timeofday_value": civil.Time{Hour: 15, Minute: 30, Second: 45, Nanosecond: 500},
+					},
+				},
+
+				{
+					name: "datetime without time offset",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDatetimeValue(&datetime.DateTime{Year: 2023, Month: 12, Day: 25, Hours: 15})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"datetime_value": civil.DateTime{
+							Date: civil.Date{Year: 2023, Month: time.December, Day: 25},
+							Time: civil.Time{Hour: 15},
+						},
+					},
+				},
+
+				{
+					name: "datetime with numeric time zone drops the time zone",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDatetimeValue(&datetime.DateTime{
+							Year:       2023,
+							Month:      12,
+							Day:        25,
+							Hours:      15,
+							TimeOffset: &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "+08:00"}},
+						})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"datetime_value": civil.DateTime{
+							Date: civil.Date{Year: 2023, Month: time.December, Day: 25},
+							Time: civil.Time{Hour: 15},
+						},
+					},
+				},
+
+				{
+					name: "latlng and wrappers",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetLatlngValue(&latlng.LatLng{Latitude: 37.7749, Longitude: -122.4194})
+						result.SetStringWrapperValue(wrapperspb.String("wrapped"))
+						result.SetUint32WrapperValue(wrapperspb.UInt32(7))
+						result.SetFloatWrapperValue(wrapperspb.Float(1.5))
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"latlng_value":         "POINT(-122.4194 37.7749)",
+						"string_wrapper_value": "wrapped",
+						"uint32_wrapper_value": int64(7),
+						"float_wrapper_value":  1.5,
+					},
+				},
+
+				{
+					name: "JSON values",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetJsonValue(structpb.NewNumberValue(1))
+						result.SetListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("a")}})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"json_value": "1",
+						"list_value": `["a"]`,
+					},
+				},
+
+				{
+					name: "negative duration",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetRepeatedDuration([]*durationpb.Duration{
+							durationpb.New(-30 * time.Second),
+							durationpb.New(0),
+						})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"repeated_duration": []bigquery.Value{"-PT30S", "PT0S"},
+					},
+				},
+				{
+					name: "duration range limits",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetRepeatedDuration([]*durationpb.Duration{
+							{Seconds: 315576000000, Nanos: 999999999},
+							{Seconds: -315576000000, Nanos: -999999999},
+						})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"repeated_duration": []bigquery.Value{"PT87660000H0.999999999S", "-PT87660000H0.999999999S"},
+					},
+				},
+			},
+		},
+
+		{
+			name: "ranges",
+			testCases: []testCase{
+				{
+					name: "date range with unbounded end",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDateRange(newDateRange("2023-01-01", ""))
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"date_range": &bigquery.RangeValue{Start: "2023-01-01"},
+					},
+				},
+
+				{
+					name: "timestamp range",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetTimestampRange(newTimestampRange(
+							timestamppb.New(mustParseTime("2023-01-01T00:00:00Z")),
+							timestamppb.New(mustParseTime("2023-12-31T23:59:59Z")),
+						))
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"timestamp_range": &bigquery.RangeValue{
+							Start: mustParseTime("2023-01-01T00:00:00Z"),
+							End:   mustParseTime("2023-12-31T23:59:59Z"),
+						},
+					},
+				},
+
+				{
+					name: "map of date ranges",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetMapStringDateRange(map[string]*testdatav1.DateRange{
+							"q1": newDateRange("2023-01-01", "2023-03-31"),
+						})
+						return &result
+					},
+					expected: map[string]bigquery.Value{
+						"map_string_date_range": []bigquery.Value{
+							map[string]bigquery.Value{
+								"key":   "q1",
+								"value": &bigquery.RangeValue{Start: "2023-01-01", End: "2023-03-31"},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		{
+			name: "errors",
+			testCases: []testCase{
+				{
+					name: "datetime with invalid time zone",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDatetimeValue(&datetime.DateTime{
+							Year:       2023,
+							Month:      12,
+							Day:        25,
+							TimeOffset: &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "Not/AZone"}},
+						})
+						return &result
+					},
+					expectedError: "datetime_value: invalid google.type.DateTime",
+				},
+
+				{
+					name: "uint64 overflowing INTEGER",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetFixed64Value(math.MaxUint64)
+						return &result
+					},
+					expectedError:     "fixed64_value: 18446744073709551615 overflows INTEGER",
+					expectedErrorKind: ErrOutOfRange,
+				},
+
+				{
+					name: "UInt64Value overflowing INTEGER",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetUint64WrapperValue(wrapperspb.UInt64(math.MaxInt64 + 1))
+						return &result
+					},
+					expectedError:     "uint64_wrapper_value: 9223372036854775808 overflows INTEGER",
+					expectedErrorKind: ErrOutOfRange,
+				},
+
+				{
+					name: "Fraction without finite decimal representation",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetFractionValue(&fraction.Fraction{Numerator: 1, Denominator: 3})
+						return &result
+					},
+					expectedError:     "fraction_value: 1/3 has no finite decimal representation",
+					expectedErrorKind: ErrPrecisionLoss,
+				},
+
+				{
+					name: "Money with another currency than the field",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetMoneyValue(&money.Money{CurrencyCode: "USD", Units: 1})
+						return &result
+					},
+					expectedError:     "money_value: currency code USD does not match the field currency EUR",
+					expectedErrorKind: ErrInvalidValue,
+				},
+
+				{
+					name: "invalid Decimal in map",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetMapStringDecimal(map[string]*decimal.Decimal{"a": {Value: "NaN"}})
+						return &result
+					},
+					expectedError:     `map_string_decimal[key="a"].value: invalid google.type.Decimal: "NaN"`,
+					expectedErrorKind: ErrInvalidValue,
+				},
+
+				{
+					name: "invalid duration",
+					message: func() proto.Message {
+						var result testdatav1.KitchenSink
+						result.SetDurationValue(&durationpb.Duration{Seconds: 1, Nanos: -1})
+						return &result
+					},
+					expectedError: "duration_value: invalid google.protobuf.Duration",
+				},
+			},
+		},
+	}
+	for _, testCaseCategory := range testCaseCategories {
+		t.Run(testCaseCategory.name, func(t *testing.T) {
+			for _, test := range testCaseCategory.testCases {
+				t.Run(test.name, func(t *testing.T) {
+					saver := MessageSaver{Message: test.message()}
+					row, _, err := saver.Save()
+					if test.expectedError != "" {
+						if err == nil {
+							t.Errorf("expected error, got nil")
+						} else if test.expectedErrorKind != nil && !errors.Is(err, test.expectedErrorKind) {
+							t.Errorf("expected %v, got %v", test.expectedErrorKind, err)
+						} else if !strings.Contains(err.Error(), test.expectedError) {
+							t.Errorf("expected error to contain %q, got %q", test.expectedError, err.Error())
+						}
+						return
+					}
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if diff := cmp.Diff(test.expected, row); diff != "" {
+						t.Errorf("expected %v, got %v, diff: %s", test.expected, row, diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestMessageSaver_roundTrip(t *testing.T) {
+	var expected testdatav1.KitchenSink
+	expected.SetStringValue("test")
+	expected.SetInt32Value(42)
+	expected.SetEnumValue(testdatav1.TestEnum_TEST_ENUM_VALUE_TWO)
+	expected.SetTimestampValue(timestamppb.New(mustParseTime("2023-12-25T15:30:45Z")))
+	expected.SetDurationValue(durationpb.New(90 * time.Minute))
+	expected.SetLatlngValue(&latlng.LatLng{Latitude: 48.8566, Longitude: 2.3522})
+	expected.SetDateRange(newDateRange("2023-01-01", "2023-12-31"))
+	expected.SetMapInt32String(map[int32]string{1: "one", -2: "minus two"})
+	expected.SetUint64WrapperValue(wrapperspb.UInt64(math.MaxInt64))
+	saver := MessageSaver{Message: &expected}
+	row, _, err := saver.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+		{Name: "int32_value", Type: bigquery.IntegerFieldType},
+		{Name: "enum_value", Type: bigquery.IntegerFieldType},
+		{Name: "timestamp_value", Type: bigquery.TimestampFieldType},
+		{Name: "duration_value", Type: bigquery.StringFieldType},
+		{Name: "latlng_value", Type: bigquery.GeographyFieldType},
+		{Name: "date_range", Type: bigquery.RangeFieldType},
+		{
+			Name:     "map_int32_string",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.IntegerFieldType},
+				{Name: "value", Type: bigquery.StringFieldType},
+			},
+		},
+		{Name: "uint64_wrapper_value", Type: bigquery.IntegerFieldType},
+	}
+	values := make([]bigquery.Value, 0, len(schema))
+	for _, fieldSchema := range schema {
+		values = append(values, row[fieldSchema.Name])
+	}
+	actual := MessageLoader{Message: &testdatav1.KitchenSink{}}
+	if err := actual.Load(values, schema); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&expected, actual.Message, protocmp.Transform()); diff != "" {
+		t.Errorf("round trip mismatch (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestMessageSaver_renamedFieldRoundTrip(t *testing.T) {
+	var expected testdatav1.RenamedFields
+	expected.SetDisplayName("Ada")
+	expected.SetUserId(42)
+	row, _, err := (&MessageSaver{Message: &expected}).Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]bigquery.Value{"display_name": "Ada", "uid": int64(42)}, row); diff != "" {
+		t.Errorf("unexpected row (-expected +actual):\n%s", diff)
+	}
+	schema := bigquery.Schema{
+		{Name: "display_name", Type: bigquery.StringFieldType},
+		{Name: "uid", Type: bigquery.IntegerFieldType},
+	}
+	actual := MessageLoader{Message: &testdatav1.RenamedFields{}}
+	if err := actual.Load([]bigquery.Value{row["display_name"], row["uid"]}, schema); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&expected, actual.Message, protocmp.Transform()); diff != "" {
+		t.Errorf("round trip mismatch (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestMessageSaver_decimalRoundTrip(t *testing.T) {
+	var expected testdatav1.KitchenSink
+	expected.SetDecimalValue(&decimal.Decimal{Value: "-123.456"})
+	expected.SetFractionValue(&fraction.Fraction{Numerator: 3, Denominator: 4})
+	expected.SetMoneyValue(&money.Money{CurrencyCode: "EUR", Units: -1, Nanos: -500000000})
+	expected.SetPrice(&money.Money{CurrencyCode: "USD", Units: 19, Nanos: 990000000})
+	expected.SetRepeatedDecimal([]*decimal.Decimal{{Value: "1"}, {Value: "0.000000001"}})
+	expected.SetMapStringDecimal(map[string]*decimal.Decimal{"a": {Value: "99999999999999999999999999999.999999999"}})
+	row, _, err := (&MessageSaver{Message: &expected}).Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := row["decimal_value"].(*big.Rat); !ok {
+		t.Fatalf("expected *big.Rat, got %T", row["decimal_value"])
+	}
+	schema := bigquery.Schema{
+		{Name: "decimal_value", Type: bigquery.NumericFieldType},
+		{Name: "fraction_value", Type: bigquery.NumericFieldType},
+		{Name: "money_value", Type: bigquery.NumericFieldType},
+		{Name: "price", Type: bigquery.NumericFieldType},
+		{Name: "price_currency", Type: bigquery.StringFieldType},
+		{Name: "repeated_decimal", Type: bigquery.NumericFieldType, Repeated: true},
+		{
+			Name:     "map_string_decimal",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.BigNumericFieldType},
+			},
+		},
+	}
+	values := make([]bigquery.Value, 0, len(schema))
+	for _, fieldSchema := range schema {
+		values = append(values, row[fieldSchema.Name])
+	}
+	actual := MessageLoader{Message: &testdatav1.KitchenSink{}}
+	if err := actual.Load(values, schema); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&expected, actual.Message, protocmp.Transform()); diff != "" {
+		t.Errorf("round trip mismatch (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestMessageSaver_loadError(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		message       func() proto.Message
+		expectedError error
+		expectedPath  string
+		expectedField protoreflect.FullName
+	}{
+		{
+			name: "field",
+			message: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint64WrapperValue(wrapperspb.UInt64(math.MaxUint64))
+				return &result
+			},
+			expectedError: ErrOutOfRange,
+			expectedPath:  "uint64_wrapper_value",
+			expectedField: "wayplatform.testdata.v1.KitchenSink.uint64_wrapper_value",
+		},
+		{
+			name: "list element",
+			message: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetRepeatedDecimal([]*decimal.Decimal{{Value: "1"}, {Value: "1/3"}})
+				return &result
+			},
+			expectedError: ErrInvalidValue,
+			expectedPath:  "repeated_decimal[1]",
+			expectedField: "wayplatform.testdata.v1.KitchenSink.repeated_decimal",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := (&MessageSaver{Message: test.message()}).Save()
+			var loadErr *LoadError
+			if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) {
+				t.Fatalf("expected %v, got %v", test.expectedError, err)
+			}
+			if loadErr.Path != test.expectedPath {
+				t.Errorf("expected path %q, got %q", test.expectedPath, loadErr.Path)
+			}
+			if loadErr.Field != test.expectedField {
+				t.Errorf("expected field %q, got %q", test.expectedField, loadErr.Field)
+			}
+		})
+	}
+}
+
+func TestMessageSaver_durationRoundTrip(t *testing.T) {
+	for _, duration := range []*durationpb.Duration{
+		{Seconds: 315576000000, Nanos: 999999999},
+		{Seconds: -315576000000, Nanos: -999999999},
+		{Seconds: 10000 * 365 * 24 * 60 * 60},
+		{Nanos: -1},
+	} {
+		t.Run(formatISO8601Duration(duration.GetSeconds(), duration.GetNanos()), func(t *testing.T) {
+			var expected testdatav1.KitchenSink
+			expected.SetDurationValue(duration)
+			row, _, err := (&MessageSaver{Message: &expected}).Save()
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := MessageLoader{Message: &testdatav1.KitchenSink{}}
+			if err := actual.Load(
+				[]bigquery.Value{row["duration_value"]},
+				bigquery.Schema{{Name: "duration_value", Type: bigquery.StringFieldType}},
+			); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(&expected, actual.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("round trip mismatch (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMessageSaver_dateTimeRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		timeZone string
+		dateTime *datetime.DateTime
+	}{
+		{
+			name:     "time zone",
+			timeZone: "Europe/Paris",
+			dateTime: &datetime.DateTime{
+				Year:       2023,
+				Month:      7,
+				Day:        14,
+				Hours:      22,
+				Minutes:    30,
+				TimeOffset: &datetime.DateTime_TimeZone{TimeZone: &datetime.TimeZone{Id: "Europe/Paris"}},
+			},
+		},
+		{
+			name:     "UTC offset",
+			timeZone: "-08:00",
+			dateTime: &datetime.DateTime{
+				Year:       2023,
+				Month:      1,
+				Day:        2,
+				Hours:      3,
+				Nanos:      4000,
+				TimeOffset: &datetime.DateTime_UtcOffset{UtcOffset: durationpb.New(-8 * time.Hour)},
+			},
+		},
+		{
+			name:     "no time offset",
+			dateTime: &datetime.DateTime{Year: 2023, Month: 1, Day: 2, Hours: 3},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var expected testdatav1.KitchenSink
+			expected.SetDatetimeValue(test.dateTime)
+			row, _, err := (&MessageSaver{Message: &expected}).Save()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := row["datetime_value"].(civil.DateTime); !ok {
+				t.Fatalf("expected civil.DateTime, got %T", row["datetime_value"])
+			}
+			actual := MessageLoader{Message: &testdatav1.KitchenSink{}, TimeZone: test.timeZone}
+			if err := actual.Load(
+				[]bigquery.Value{row["datetime_value"]},
+				bigquery.Schema{{Name: "datetime_value", Type: bigquery.DateTimeFieldType}},
+			); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(&expected, actual.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("round trip mismatch (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package protobq
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NullMode controls how MessageLoader loads NULL values into singular fields.
+//
+// By default, a NULL value leaves the field unset: fields with explicit presence, such as
+// proto3 optional fields, message fields and fields of editions, are not present, and fields
+// with implicit presence keep their zero value. NULL values of oneof members never select
+// their member, regardless of the mode.
+type NullMode int
+
+const (
+	// NullUnset leaves fields with a NULL value unset.
+	NullUnset NullMode = iota
+	// NullStrict returns ErrNullValue for NULL values of required fields,
+	// such as proto2 required fields and fields with LEGACY_REQUIRED presence,
+	// and leaves other fields unset.
+	NullStrict
+	// NullStrictPresence returns ErrNullValue for NULL values of fields with explicit presence,
+	// including required fields, and leaves fields with implicit presence unset.
+	NullStrictPresence
+	// NullDefault sets fields with a NULL value to their default value, making fields with explicit
+	// presence present: the declared default of proto2 and editions fields, or the zero value,
+	// and an empty message for message fields.
+	NullDefault
+)
+
+// String returns a string representation of the null mode.
+func (m NullMode) String() string {
+	switch m {
+	case NullUnset:
+		return "unset"
+	case NullStrict:
+		return "strict"
+	case NullStrictPresence:
+		return "strict-presence"
+	case NullDefault:
+		return "default"
+	default:
+		return fmt.Sprintf("NullMode(%d)", int(m))
+	}
+}
+
+// loadNull loads a NULL value into the singular field, according to the NullMode.
+func (o *MessageLoader) loadNull(field protoreflect.FieldDescriptor, message protoreflect.Message) error {
+	if oneof := field.ContainingOneof(); oneof == nil || oneof.IsSynthetic() {
+		switch {
+		case o.NullMode == NullStrict && field.Cardinality() == protoreflect.Required,
+			o.NullMode == NullStrictPresence && field.HasPresence():
+			return newLoadError(ErrNullValue, nil, "NULL value for field %s", field.Name())
+		case o.NullMode == NullDefault:
+			if field.Message() != nil {
+				message.Set(field, message.NewField(field))
+			} else {
+				message.Set(field, field.Default())
+			}
+			return nil
+		}
+	}
+	if o.clearsNull() {
+		message.Clear(field)
+	}
+	return nil
+}
+
+// isNullRecord reports whether all columns of the RECORD value are NULL.
+// Nested RECORD columns are NULL if all their columns are NULL, and repeated columns if they are empty.
+func isNullRecord(bqValue bigquery.Value, bqSchema bigquery.Schema) bool {
+	bqRecord, ok := bqValue.([]bigquery.Value)
+	if !ok || len(bqRecord) != len(bqSchema) {
+		return false
+	}
+	for i, bqFieldSchema := range bqSchema {
+		switch bqField := bqRecord[i]; {
+		case bqField == nil:
+		case bqFieldSchema.Repeated:
+			if bqList, ok := bqField.([]bigquery.Value); !ok || len(bqList) > 0 {
+				return false
+			}
+		case bqFieldSchema.Type == bigquery.RecordFieldType:
+			if !isNullRecord(bqField, bqFieldSchema.Schema) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
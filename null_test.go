@@ -0,0 +1,199 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMessageLoader_null(t *testing.T) {
+	nestedSchema := bigquery.Schema{
+		{Name: "text", Type: bigquery.StringFieldType},
+		{Name: "number", Type: bigquery.IntegerFieldType},
+		{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+	}
+	schema := bigquery.Schema{
+		{Name: "id", Type: bigquery.StringFieldType},
+		{Name: "count", Type: bigquery.IntegerFieldType},
+		{Name: "implicit_count", Type: bigquery.IntegerFieldType},
+		{Name: "nested", Type: bigquery.RecordFieldType, Schema: nestedSchema},
+		{Name: "required_nested", Type: bigquery.RecordFieldType, Schema: nestedSchema},
+	}
+	nullRecord := []bigquery.Value{nil, nil, []bigquery.Value{}}
+	record := []bigquery.Value{"t", nil, []bigquery.Value{}}
+	fullRecord := []bigquery.Value{"t", int64(2), []bigquery.Value{}}
+	for _, test := range []struct {
+		name          string
+		mode          NullMode
+		nullRecords   bool
+		row           []bigquery.Value
+		expected      func() proto.Message
+		expectedError error
+		expectedPath  string
+	}{
+		{
+			name: "NULL values",
+			row:  []bigquery.Value{"a", nil, nil, nil, record},
+			expected: func() proto.Message {
+				var required testdatav1.NestedMessage
+				required.SetText("t")
+				var result testdatav1.FieldPresence
+				result.SetId("a")
+				result.SetRequiredNested(&required)
+				return &result
+			},
+		},
+		{
+			name: "zero values",
+			row:  []bigquery.Value{"", int64(0), int64(0), nil, record},
+			expected: func() proto.Message {
+				var required testdatav1.NestedMessage
+				required.SetText("t")
+				var result testdatav1.FieldPresence
+				result.SetId("")
+				result.SetCount(0)
+				result.SetRequiredNested(&required)
+				return &result
+			},
+		},
+		{
+			name: "all-NULL RECORD",
+			row:  []bigquery.Value{"a", nil, nil, nullRecord, nullRecord},
+			expected: func() proto.Message {
+				var result testdatav1.FieldPresence
+				result.SetId("a")
+				result.SetNested(&testdatav1.NestedMessage{})
+				result.SetRequiredNested(&testdatav1.NestedMessage{})
+				return &result
+			},
+		},
+		{
+			name:        "all-NULL RECORD as NULL",
+			nullRecords: true,
+			row:         []bigquery.Value{"a", nil, nil, nullRecord, record},
+			expected: func() proto.Message {
+				var required testdatav1.NestedMessage
+				required.SetText("t")
+				var result testdatav1.FieldPresence
+				result.SetId("a")
+				result.SetRequiredNested(&required)
+				return &result
+			},
+		},
+		{
+			name: "NULL required field",
+			row:  []bigquery.Value{nil, nil, nil, nil, nil},
+			expected: func() proto.Message {
+				return &testdatav1.FieldPresence{}
+			},
+		},
+		{
+			name:          "strict NULL required field",
+			mode:          NullStrict,
+			row:           []bigquery.Value{nil, int64(1), nil, nil, record},
+			expectedError: ErrNullValue,
+			expectedPath:  "id",
+		},
+		{
+			name:          "strict NULL required RECORD",
+			mode:          NullStrict,
+			row:           []bigquery.Value{"a", nil, nil, nil, nil},
+			expectedError: ErrNullValue,
+			expectedPath:  "required_nested",
+		},
+		{
+			name:          "strict presence NULL field with explicit presence",
+			mode:          NullStrictPresence,
+			row:           []bigquery.Value{"a", nil, nil, nil, record},
+			expectedError: ErrNullValue,
+			expectedPath:  "count",
+		},
+		{
+			name:          "strict presence NULL RECORD",
+			mode:          NullStrictPresence,
+			row:           []bigquery.Value{"a", int64(1), nil, nil, record},
+			expectedError: ErrNullValue,
+			expectedPath:  "nested",
+		},
+		{
+			name: "strict presence NULL field with implicit presence",
+			mode: NullStrictPresence,
+			row:  []bigquery.Value{"a", int64(1), nil, fullRecord, fullRecord},
+			expected: func() proto.Message {
+				var nested testdatav1.NestedMessage
+				nested.SetText("t")
+				nested.SetNumber(2)
+				var result testdatav1.FieldPresence
+				result.SetId("a")
+				result.SetCount(1)
+				result.SetNested(&nested)
+				result.SetRequiredNested(&nested)
+				return &result
+			},
+		},
+		{
+			name: "default NULL values",
+			mode: NullDefault,
+			row:  []bigquery.Value{nil, nil, nil, nil, nil},
+			expected: func() proto.Message {
+				var result testdatav1.FieldPresence
+				result.SetId("")
+				result.SetCount(0)
+				result.SetNested(&testdatav1.NestedMessage{})
+				result.SetRequiredNested(&testdatav1.NestedMessage{})
+				return &result
+			},
+		},
+		{
+			name:          "strict all-NULL required RECORD as NULL",
+			mode:          NullStrict,
+			nullRecords:   true,
+			row:           []bigquery.Value{"a", nil, nil, nil, nullRecord},
+			expectedError: ErrNullValue,
+			expectedPath:  "required_nested",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{
+				Message:     &testdatav1.FieldPresence{},
+				NullMode:    test.mode,
+				NullRecords: test.nullRecords,
+			}
+			err := loader.Load(test.row, schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				if loadErr.Path != test.expectedPath {
+					t.Errorf("expected path %q, got %q", test.expectedPath, loadErr.Path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+	t.Run("default NULL oneof member", func(t *testing.T) {
+		loader := MessageLoader{Message: &testdatav1.NestedMessage{}, NullMode: NullDefault}
+		err := loader.Load([]bigquery.Value{int64(1), nil}, bigquery.Schema{
+			{Name: "int_option", Type: bigquery.IntegerFieldType},
+			{Name: "bool_option", Type: bigquery.BooleanFieldType},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if message := loader.Message.(*testdatav1.NestedMessage); !message.HasIntOption() || message.GetIntOption() != 1 {
+			t.Errorf("expected int_option 1, got %v", message)
+		}
+	})
+}
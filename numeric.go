@@ -0,0 +1,323 @@
+package protobq
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/fraction"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	wktDecimal  = "google.type.Decimal"
+	wktMoney    = "google.type.Money"
+	wktFraction = "google.type.Fraction"
+)
+
+// isDecimalType reports whether messages of the type are loaded from NUMERIC and BIGNUMERIC columns.
+func isDecimalType(t protoreflect.FullName) bool {
+	return t == wktDecimal || t == wktMoney || t == wktFraction
+}
+
+// isNumericFieldType reports whether the BigQuery type is NUMERIC or BIGNUMERIC.
+func isNumericFieldType(bqType bigquery.FieldType) bool {
+	return bqType == bigquery.NumericFieldType || bqType == bigquery.BigNumericFieldType
+}
+
+// numericRat converts a NUMERIC or BIGNUMERIC value to a *big.Rat.
+// The BigQuery client returns *big.Rat values, other sources may return decimal strings.
+func numericRat(bqValue bigquery.Value) (*big.Rat, error) {
+	switch v := bqValue.(type) {
+	case *big.Rat:
+		return v, nil
+	case string:
+		r, ok := parseNumeric(v)
+		if !ok {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "invalid NUMERIC string: %q", v)
+		}
+		return r, nil
+	default:
+		return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for NUMERIC: %#v", bqValue)
+	}
+}
+
+// parseNumeric parses a decimal string, e.g. "-123.456" or "1.5E+3".
+func parseNumeric(str string) (*big.Rat, bool) {
+	if strings.ContainsRune(str, '/') {
+		return nil, false
+	}
+	return new(big.Rat).SetString(str)
+}
+
+// RoundingMode controls how MessageLoader rounds NUMERIC and BIGNUMERIC values with more
+// fractional digits than an integral field can hold, e.g. 1.5 into an int64 field or
+// 0.0000000001 into the nanos of a google.type.Money field.
+//
+// It does not apply to floating point fields, which are always rounded to the nearest value.
+// Values outside the range of the field are never rounded, and fail with ErrOutOfRange.
+type RoundingMode int
+
+const (
+	// RoundUnnecessary returns ErrPrecisionLoss for values that need rounding to an integral field.
+	RoundUnnecessary RoundingMode = iota
+	// RoundHalfEven rounds to the nearest value, and ties to the even value.
+	RoundHalfEven
+	// RoundHalfAwayFromZero rounds to the nearest value, and ties away from zero.
+	RoundHalfAwayFromZero
+	// RoundTowardZero rounds toward zero, truncating the fractional digits.
+	RoundTowardZero
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+)
+
+// String returns a string representation of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundUnnecessary:
+		return "unnecessary"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfAwayFromZero:
+		return "half-away-from-zero"
+	case RoundTowardZero:
+		return "toward-zero"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+}
+
+// round rounds the rational to an integer.
+// It returns false if the rational is not an integer and the mode is RoundUnnecessary.
+func (m RoundingMode) round(r *big.Rat) (*big.Int, bool) {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num()), true
+	}
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	var awayFromZero bool
+	switch m {
+	case RoundTowardZero:
+	case RoundFloor:
+		awayFromZero = r.Sign() < 0
+	case RoundCeiling:
+		awayFromZero = r.Sign() > 0
+	case RoundHalfEven, RoundHalfAwayFromZero:
+		twiceRemainder := remainder.Lsh(remainder.Abs(remainder), 1)
+		switch twiceRemainder.Cmp(r.Denom()) {
+		case 1:
+			awayFromZero = true
+		case 0:
+			awayFromZero = m == RoundHalfAwayFromZero || quotient.Bit(0) == 1
+		}
+	default:
+		return nil, false
+	}
+	if awayFromZero {
+		quotient.Add(quotient, big.NewInt(int64(r.Sign())))
+	}
+	return quotient, true
+}
+
+// unmarshalNumeric loads a NUMERIC or BIGNUMERIC value into a scalar field.
+func (o *MessageLoader) unmarshalNumeric(r *big.Rat, field protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		str, err := numericDecimalString(r)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfString(str), nil
+	case protoreflect.BytesKind:
+		str, err := numericDecimalString(r)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBytes([]byte(str)), nil
+	}
+	value, err := o.numericScalar(r, field.Kind())
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return value, nil
+}
+
+// numericScalar converts a rational to a value of the numeric field kind.
+// Integer kinds are rounded with the RoundingMode, and fail with ErrOutOfRange for values outside of their bounds.
+// Floating point kinds are rounded to the nearest value, and fail with ErrOutOfRange on overflow.
+func (o *MessageLoader) numericScalar(r *big.Rat, kind protoreflect.Kind) (protoreflect.Value, *LoadError) {
+	switch kind {
+	case protoreflect.DoubleKind:
+		f, _ := r.Float64()
+		if math.IsInf(f, 0) {
+			return protoreflect.Value{}, newLoadError(ErrOutOfRange, r, "%s overflows %v", r.RatString(), kind)
+		}
+		return protoreflect.ValueOfFloat64(f), nil
+	case protoreflect.FloatKind:
+		f, _ := r.Float32()
+		if math.IsInf(float64(f), 0) {
+			return protoreflect.Value{}, newLoadError(ErrOutOfRange, r, "%s overflows %v", r.RatString(), kind)
+		}
+		return protoreflect.ValueOfFloat32(f), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := o.numericInt64(r, kind, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := o.numericInt64(r, kind, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := o.numericUint64(r, kind, math.MaxUint32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := o.numericUint64(r, kind, math.MaxUint64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	default:
+		return protoreflect.Value{}, newLoadError(ErrTypeMismatch, r, "unsupported protobuf kind for NUMERIC: %v", kind)
+	}
+}
+
+func (o *MessageLoader) numericInt64(r *big.Rat, kind protoreflect.Kind, minValue, maxValue int64) (int64, *LoadError) {
+	n, ok := o.RoundingMode.round(r)
+	if !ok {
+		return 0, newLoadError(ErrPrecisionLoss, r, "%s has a fractional part for %v", r.RatString(), kind)
+	}
+	if !n.IsInt64() || n.Int64() < minValue || n.Int64() > maxValue {
+		return 0, newLoadError(ErrOutOfRange, r, "%s overflows %v", r.RatString(), kind)
+	}
+	return n.Int64(), nil
+}
+
+func (o *MessageLoader) numericUint64(r *big.Rat, kind protoreflect.Kind, maxValue uint64) (uint64, *LoadError) {
+	n, ok := o.RoundingMode.round(r)
+	if !ok {
+		return 0, newLoadError(ErrPrecisionLoss, r, "%s has a fractional part for %v", r.RatString(), kind)
+	}
+	if !n.IsUint64() || n.Uint64() > maxValue {
+		return 0, newLoadError(ErrOutOfRange, r, "%s overflows %v", r.RatString(), kind)
+	}
+	return n.Uint64(), nil
+}
+
+// numericDecimalString formats a rational as a decimal string with the fewest fractional digits.
+// It fails with ErrPrecisionLoss if the rational has no finite decimal representation.
+func numericDecimalString(r *big.Rat) (string, error) {
+	if r.IsInt() {
+		return r.Num().String(), nil
+	}
+	// A fraction has a finite decimal representation if its denominator is of the form 2^a * 5^b,
+	// in which case it has max(a, b) fractional digits.
+	denominator := new(big.Int).Set(r.Denom())
+	twos := denominator.TrailingZeroBits()
+	denominator.Rsh(denominator, twos)
+	var fives uint
+	five, quotient, remainder := big.NewInt(5), new(big.Int), new(big.Int)
+	for {
+		quotient.QuoRem(denominator, five, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		denominator.Set(quotient)
+		fives++
+	}
+	if !denominator.IsInt64() || denominator.Int64() != 1 {
+		return "", newLoadError(ErrPrecisionLoss, r, "%s has no finite decimal representation", r.RatString())
+	}
+	return r.FloatString(int(max(twos, fives))), nil
+}
+
+func (o *MessageLoader) unmarshalDecimal(bqValue bigquery.Value) (*decimal.Decimal, error) {
+	r, err := numericRat(bqValue)
+	if err != nil {
+		return nil, err
+	}
+	value, err := numericDecimalString(r)
+	if err != nil {
+		return nil, err
+	}
+	return &decimal.Decimal{Value: value}, nil
+}
+
+func (o *MessageLoader) unmarshalFraction(bqValue bigquery.Value) (*fraction.Fraction, error) {
+	r, err := numericRat(bqValue)
+	if err != nil {
+		return nil, err
+	}
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return nil, newLoadError(ErrOutOfRange, bqValue, "%s overflows %s", r.RatString(), wktFraction)
+	}
+	return &fraction.Fraction{Numerator: r.Num().Int64(), Denominator: r.Denom().Int64()}, nil
+}
+
+// unmarshalMoney loads a NUMERIC value into a google.type.Money, with the currency code
+// set by the field option (protobq.v1.field).currency_code.
+// Values with more than 9 fractional digits are rounded with the RoundingMode.
+// The units and nanos have the same sign, as required by google.type.Money.
+func (o *MessageLoader) unmarshalMoney(bqValue bigquery.Value, field protoreflect.FieldDescriptor) (*money.Money, error) {
+	r, err := numericRat(bqValue)
+	if err != nil {
+		return nil, err
+	}
+	totalNanos, ok := o.RoundingMode.round(new(big.Rat).Mul(r, big.NewRat(1e9, 1)))
+	if !ok {
+		return nil, newLoadError(ErrPrecisionLoss, bqValue, "%s has more than 9 fractional digits for %s", r.RatString(), wktMoney)
+	}
+	units, nanos := totalNanos.QuoRem(totalNanos, big.NewInt(1e9), new(big.Int))
+	if !units.IsInt64() {
+		return nil, newLoadError(ErrOutOfRange, bqValue, "%s overflows %s", r.RatString(), wktMoney)
+	}
+	result := &money.Money{Units: units.Int64(), Nanos: int32(nanos.Int64())}
+	if fieldOptions := fieldOptions(field); fieldOptions != nil {
+		result.CurrencyCode = fieldOptions.GetCurrencyCode()
+	}
+	return result, nil
+}
+
+// currencyColumnOption returns the currency column set by the field option (protobq.v1.field).currency_column.
+func currencyColumnOption(field protoreflect.FieldDescriptor) (string, bool) {
+	if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+		return "", false
+	}
+	if field.Message().FullName() != wktMoney {
+		return "", false
+	}
+	fieldOptions := fieldOptions(field)
+	if fieldOptions == nil || !fieldOptions.HasCurrencyColumn() {
+		return "", false
+	}
+	return fieldOptions.GetCurrencyColumn(), true
+}
+
+// setMoneyCurrency sets the currency code of a loaded google.type.Money field from the value of its currency column.
+func setMoneyCurrency(bqValue bigquery.Value, field protoreflect.FieldDescriptor, message protoreflect.Message) error {
+	if bqValue == nil || !message.Has(field) {
+		return nil
+	}
+	currencyCode, ok := bqValue.(string)
+	if !ok {
+		return newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for currency code: %#v", bqValue)
+	}
+	moneyMessage := message.Mutable(field).Message()
+	moneyMessage.Set(moneyMessage.Descriptor().Fields().ByName("currency_code"), protoreflect.ValueOfString(currencyCode))
+	return nil
+}
@@ -0,0 +1,442 @@
+package protobq
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/fraction"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMessageLoader_numeric(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		rounding      RoundingMode
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "Decimal from *big.Rat",
+			row:    []bigquery.Value{big.NewRat(-123456, 1000)},
+			schema: bigquery.Schema{{Name: "decimal_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDecimalValue(&decimal.Decimal{Value: "-123.456"})
+				return &result
+			},
+		},
+		{
+			name:   "Decimal from BIGNUMERIC string",
+			row:    []bigquery.Value{"123456789.12345678912345678912345678900"},
+			schema: bigquery.Schema{{Name: "decimal_value", Type: bigquery.BigNumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDecimalValue(&decimal.Decimal{Value: "123456789.123456789123456789123456789"})
+				return &result
+			},
+		},
+		{
+			name:   "Decimal from RECORD",
+			row:    []bigquery.Value{[]bigquery.Value{"1.5"}},
+			schema: bigquery.Schema{{Name: "decimal_value", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{{Name: "value", Type: bigquery.StringFieldType}}}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDecimalValue(&decimal.Decimal{Value: "1.5"})
+				return &result
+			},
+		},
+		{
+			name:   "Fraction",
+			row:    []bigquery.Value{big.NewRat(3, 4)},
+			schema: bigquery.Schema{{Name: "fraction_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetFractionValue(&fraction.Fraction{Numerator: 3, Denominator: 4})
+				return &result
+			},
+		},
+		{
+			name:   "Money with currency option",
+			row:    []bigquery.Value{big.NewRat(-3, 2)},
+			schema: bigquery.Schema{{Name: "money_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetMoneyValue(&money.Money{CurrencyCode: "EUR", Units: -1, Nanos: -500000000})
+				return &result
+			},
+		},
+		{
+			name: "Money with currency column",
+			row:  []bigquery.Value{"SEK", "19.99"},
+			schema: bigquery.Schema{
+				{Name: "price_currency", Type: bigquery.StringFieldType},
+				{Name: "price", Type: bigquery.NumericFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetPrice(&money.Money{CurrencyCode: "SEK", Units: 19, Nanos: 990000000})
+				return &result
+			},
+		},
+		{
+			name: "Money with null value",
+			row:  []bigquery.Value{nil, "SEK"},
+			schema: bigquery.Schema{
+				{Name: "price", Type: bigquery.NumericFieldType},
+				{Name: "price_currency", Type: bigquery.StringFieldType},
+			},
+			expected: func() proto.Message {
+				return &testdatav1.KitchenSink{}
+			},
+		},
+		{
+			name: "repeated Decimal",
+			row:  []bigquery.Value{[]bigquery.Value{big.NewRat(1, 8), "2"}},
+			schema: bigquery.Schema{
+				{Name: "repeated_decimal", Type: bigquery.NumericFieldType, Repeated: true},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetRepeatedDecimal([]*decimal.Decimal{{Value: "0.125"}, {Value: "2"}})
+				return &result
+			},
+		},
+		{
+			name: "map Decimal",
+			row:  []bigquery.Value{[]bigquery.Value{[]bigquery.Value{"a", big.NewRat(5, 2)}}},
+			schema: bigquery.Schema{
+				{
+					Name:     "map_string_decimal",
+					Type:     bigquery.RecordFieldType,
+					Repeated: true,
+					Schema: bigquery.Schema{
+						{Name: "key", Type: bigquery.StringFieldType},
+						{Name: "value", Type: bigquery.NumericFieldType},
+					},
+				},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetMapStringDecimal(map[string]*decimal.Decimal{"a": {Value: "2.5"}})
+				return &result
+			},
+		},
+		{
+			name: "scalars from *big.Rat",
+			row: []bigquery.Value{
+				big.NewRat(-7, 1),
+				big.NewRat(1<<40, 1),
+				big.NewRat(7, 1),
+				new(big.Rat).SetUint64(1<<63 + 1),
+				big.NewRat(1, 4),
+				big.NewRat(1, 8),
+				big.NewRat(-314, 100),
+				big.NewRat(-314, 100),
+			},
+			schema: bigquery.Schema{
+				{Name: "sint32_value", Type: bigquery.NumericFieldType},
+				{Name: "int64_value", Type: bigquery.NumericFieldType},
+				{Name: "fixed32_value", Type: bigquery.NumericFieldType},
+				{Name: "uint64_value", Type: bigquery.BigNumericFieldType},
+				{Name: "double_value", Type: bigquery.NumericFieldType},
+				{Name: "float_value", Type: bigquery.NumericFieldType},
+				{Name: "string_value", Type: bigquery.NumericFieldType},
+				{Name: "bytes_value", Type: bigquery.NumericFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetSint32Value(-7)
+				result.SetInt64Value(1 << 40)
+				result.SetFixed32Value(7)
+				result.SetUint64Value(1<<63 + 1)
+				result.SetDoubleValue(0.25)
+				result.SetFloatValue(0.125)
+				result.SetStringValue("-3.14")
+				result.SetBytesValue([]byte("-3.14"))
+				return &result
+			},
+		},
+		{
+			name: "wrappers from *big.Rat",
+			row: []bigquery.Value{
+				big.NewRat(-1, 1),
+				big.NewRat(1, 1),
+				big.NewRat(2, 1),
+				big.NewRat(3, 1),
+				big.NewRat(1, 2),
+				big.NewRat(3, 2),
+				big.NewRat(5, 2),
+				big.NewRat(7, 2),
+			},
+			schema: bigquery.Schema{
+				{Name: "int32_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "int64_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "uint32_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "uint64_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "float_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "double_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "string_wrapper_value", Type: bigquery.NumericFieldType},
+				{Name: "bytes_wrapper_value", Type: bigquery.NumericFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt32WrapperValue(wrapperspb.Int32(-1))
+				result.SetInt64WrapperValue(wrapperspb.Int64(1))
+				result.SetUint32WrapperValue(wrapperspb.UInt32(2))
+				result.SetUint64WrapperValue(wrapperspb.UInt64(3))
+				result.SetFloatWrapperValue(wrapperspb.Float(0.5))
+				result.SetDoubleWrapperValue(wrapperspb.Double(1.5))
+				result.SetStringWrapperValue(wrapperspb.String("2.5"))
+				result.SetBytesWrapperValue(wrapperspb.Bytes([]byte("3.5")))
+				return &result
+			},
+		},
+		{
+			name:     "rounded int64",
+			rounding: RoundHalfEven,
+			row:      []bigquery.Value{big.NewRat(-5, 2), big.NewRat(5, 2)},
+			schema: bigquery.Schema{
+				{Name: "int64_value", Type: bigquery.NumericFieldType},
+				{Name: "int64_wrapper_value", Type: bigquery.NumericFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(-2)
+				result.SetInt64WrapperValue(wrapperspb.Int64(2))
+				return &result
+			},
+		},
+		{
+			name:     "rounded Money",
+			rounding: RoundHalfAwayFromZero,
+			row:      []bigquery.Value{"-1.0000000005"},
+			schema:   bigquery.Schema{{Name: "money_value", Type: bigquery.BigNumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetMoneyValue(&money.Money{CurrencyCode: "EUR", Units: -1, Nanos: -1})
+				return &result
+			},
+		},
+		{
+			name:     "floating point fields ignore the rounding mode",
+			rounding: RoundFloor,
+			row:      []bigquery.Value{"19.99", "19.99", big.NewRat(-1, 3)},
+			schema: bigquery.Schema{
+				{Name: "int64_value", Type: bigquery.NumericFieldType},
+				{Name: "double_value", Type: bigquery.NumericFieldType},
+				{Name: "float_wrapper_value", Type: bigquery.NumericFieldType},
+			},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetInt64Value(19)
+				result.SetDoubleValue(19.99)
+				result.SetFloatWrapperValue(wrapperspb.Float(-1.0 / 3))
+				return &result
+			},
+		},
+		{
+			name: "int64 with fractional part next to double",
+			row:  []bigquery.Value{"19.99", "19.99"},
+			schema: bigquery.Schema{
+				{Name: "double_value", Type: bigquery.NumericFieldType},
+				{Name: "int64_value", Type: bigquery.NumericFieldType},
+			},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "int32 wrapper with fractional part",
+			row:           []bigquery.Value{big.NewRat(1, 2)},
+			schema:        bigquery.Schema{{Name: "int32_wrapper_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:     "rounded uint32",
+			rounding: RoundFloor,
+			row:      []bigquery.Value{new(big.Rat).SetFloat64(4294967295.5)},
+			schema:   bigquery.Schema{{Name: "uint32_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetUint32Value(4294967295)
+				return &result
+			},
+		},
+		{
+			name:          "uint32 overflow after rounding",
+			rounding:      RoundCeiling,
+			row:           []bigquery.Value{new(big.Rat).SetFloat64(4294967295.5)},
+			schema:        bigquery.Schema{{Name: "uint32_wrapper_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "bool from *big.Rat",
+			row:           []bigquery.Value{big.NewRat(1, 1)},
+			schema:        bigquery.Schema{{Name: "bool_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:          "Money with more than 9 fractional digits",
+			row:           []bigquery.Value{"1.0000000001"},
+			schema:        bigquery.Schema{{Name: "money_value", Type: bigquery.BigNumericFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "Money overflow",
+			row:           []bigquery.Value{"99999999999999999999"},
+			schema:        bigquery.Schema{{Name: "money_value", Type: bigquery.BigNumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "Fraction overflow",
+			row:           []bigquery.Value{"0.00000000000000000000000000000000000001"},
+			schema:        bigquery.Schema{{Name: "fraction_value", Type: bigquery.BigNumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "invalid Decimal",
+			row:           []bigquery.Value{"1/3"},
+			schema:        bigquery.Schema{{Name: "decimal_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrInvalidValue,
+		},
+		{
+			name:          "unsupported Decimal value",
+			row:           []bigquery.Value{1.5},
+			schema:        bigquery.Schema{{Name: "decimal_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name: "invalid currency",
+			row:  []bigquery.Value{"1", int64(1)},
+			schema: bigquery.Schema{
+				{Name: "price", Type: bigquery.NumericFieldType},
+				{Name: "price_currency", Type: bigquery.IntegerFieldType},
+			},
+			expectedError: ErrTypeMismatch,
+		},
+		{
+			name:          "int64 with fractional part",
+			row:           []bigquery.Value{"1.5"},
+			schema:        bigquery.Schema{{Name: "int64_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrPrecisionLoss,
+		},
+		{
+			name:          "int32 overflow",
+			row:           []bigquery.Value{"2147483648"},
+			schema:        bigquery.Schema{{Name: "int32_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:          "negative uint64",
+			row:           []bigquery.Value{"-1"},
+			schema:        bigquery.Schema{{Name: "uint64_value", Type: bigquery.NumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+		{
+			name:   "38-digit NUMERIC to double",
+			row:    []bigquery.Value{"12345678901234567890123456789.123456789"},
+			schema: bigquery.Schema{{Name: "double_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetDoubleValue(12345678901234567890123456789.123456789)
+				return &result
+			},
+		},
+		{
+			name:   "inexact float",
+			row:    []bigquery.Value{big.NewRat(1, 10)},
+			schema: bigquery.Schema{{Name: "float_value", Type: bigquery.NumericFieldType}},
+			expected: func() proto.Message {
+				var result testdatav1.KitchenSink
+				result.SetFloatValue(0.1)
+				return &result
+			},
+		},
+		{
+			name:          "float overflow",
+			row:           []bigquery.Value{"1e39"},
+			schema:        bigquery.Schema{{Name: "float_value", Type: bigquery.BigNumericFieldType}},
+			expectedError: ErrOutOfRange,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{Message: &testdatav1.KitchenSink{}, RoundingMode: test.rounding}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				var loadErr *LoadError
+				if !errors.Is(err, test.expectedError) || !errors.As(err, &loadErr) || loadErr.Field == "" {
+					t.Fatalf("expected %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), loader.Message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNumericDecimalString(t *testing.T) {
+	for _, test := range []struct {
+		value    *big.Rat
+		expected string
+	}{
+		{value: big.NewRat(0, 1), expected: "0"},
+		{value: big.NewRat(-42, 1), expected: "-42"},
+		{value: big.NewRat(1, 2), expected: "0.5"},
+		{value: big.NewRat(-1, 1000000000), expected: "-0.000000001"},
+		{value: big.NewRat(7, 40), expected: "0.175"},
+	} {
+		t.Run(test.expected, func(t *testing.T) {
+			actual, err := numericDecimalString(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+	if _, err := numericDecimalString(big.NewRat(1, 3)); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("expected %v for 1/3, got %v", ErrPrecisionLoss, err)
+	}
+}
+
+func TestRoundingMode_round(t *testing.T) {
+	values := []*big.Rat{
+		big.NewRat(5, 2), big.NewRat(3, 2), big.NewRat(7, 5), big.NewRat(-5, 2), big.NewRat(-7, 5), big.NewRat(2, 1),
+	}
+	for _, test := range []struct {
+		mode     RoundingMode
+		expected []int64
+	}{
+		{mode: RoundHalfEven, expected: []int64{2, 2, 1, -2, -1, 2}},
+		{mode: RoundHalfAwayFromZero, expected: []int64{3, 2, 1, -3, -1, 2}},
+		{mode: RoundTowardZero, expected: []int64{2, 1, 1, -2, -1, 2}},
+		{mode: RoundFloor, expected: []int64{2, 1, 1, -3, -2, 2}},
+		{mode: RoundCeiling, expected: []int64{3, 2, 2, -2, -1, 2}},
+	} {
+		t.Run(test.mode.String(), func(t *testing.T) {
+			for i, value := range values {
+				actual, ok := test.mode.round(value)
+				if !ok || actual.Int64() != test.expected[i] {
+					t.Errorf("expected %s to round to %d, got %v", value.RatString(), test.expected[i], actual)
+				}
+			}
+		})
+	}
+	if _, ok := RoundUnnecessary.round(big.NewRat(1, 2)); ok {
+		t.Error("expected 1/2 to need rounding")
+	}
+}
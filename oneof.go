@@ -0,0 +1,150 @@
+package protobq
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OneofMode controls how MessageLoader loads rows with more than one non-null member of a oneof.
+//
+// Regardless of the mode, a STRING column named after a oneof is loaded as a discriminator
+// of the "flattened oneof" layout: it holds the name of the active case, and only the column
+// of the active case is loaded. A NULL discriminator means that no case is active.
+type OneofMode int
+
+const (
+	// OneofLenient loads the first non-null member of a oneof and ignores the others.
+	OneofLenient OneofMode = iota
+	// OneofStrict returns an error if more than one member of a oneof is non-null.
+	OneofStrict
+)
+
+// String returns a string representation of the oneof mode.
+func (m OneofMode) String() string {
+	switch m {
+	case OneofLenient:
+		return "lenient"
+	case OneofStrict:
+		return "strict"
+	default:
+		return fmt.Sprintf("OneofMode(%d)", int(m))
+	}
+}
+
+// oneofLoader tracks the members of the oneofs of a message while loading a row.
+type oneofLoader struct {
+	mode OneofMode
+	// cases holds the active case of each oneof with a discriminator column.
+	// A nil case means that no case is active.
+	cases map[protoreflect.OneofDescriptor]protoreflect.FieldDescriptor
+	// loaded holds the member loaded for each oneof.
+	loaded map[protoreflect.OneofDescriptor]protoreflect.FieldDescriptor
+}
+
+// newOneofLoader returns a oneofLoader for the message of the plan, reading the discriminator columns of the row.
+// It returns nil if the message has no oneofs.
+func (o *MessageLoader) newOneofLoader(
+	bqMessage []bigquery.Value,
+	bqSchema bigquery.Schema,
+	plan *loadPlan,
+) (*oneofLoader, error) {
+	if !plan.hasOneofs {
+		return nil, nil
+	}
+	l := &oneofLoader{mode: o.OneofMode}
+	for _, i := range plan.discriminators {
+		bqFieldSchema := bqSchema[i]
+		oneof := plan.columns[i].discriminator
+		oneofCase, err := o.loadOneofCase(bqMessage[i], bqFieldSchema, plan.message, oneof)
+		if err != nil {
+			return nil, wrapLoadError(err, bqFieldSchema.Name, bqFieldSchema, nil)
+		}
+		if l.cases == nil {
+			l.cases = make(map[protoreflect.OneofDescriptor]protoreflect.FieldDescriptor)
+		}
+		l.cases[oneof] = oneofCase
+	}
+	return l, nil
+}
+
+func (o *MessageLoader) loadOneofCase(
+	bqField bigquery.Value,
+	bqFieldSchema *bigquery.FieldSchema,
+	message protoreflect.MessageDescriptor,
+	oneof protoreflect.OneofDescriptor,
+) (protoreflect.FieldDescriptor, error) {
+	if bqFieldSchema.Type != bigquery.StringFieldType || bqFieldSchema.Repeated {
+		return nil, newLoadError(
+			ErrSchemaMismatch, bqField, "unsupported field schema for oneof discriminator %s: %s", oneof.Name(), bqFieldSchema.Type,
+		)
+	}
+	if bqField == nil {
+		return nil, nil
+	}
+	name, ok := bqField.(string)
+	if !ok {
+		return nil, newLoadError(ErrTypeMismatch, bqField, "invalid BigQuery value %#v for oneof discriminator", bqField)
+	}
+	field := o.resolveField(message, name)
+	if field == nil || field.ContainingOneof() != oneof {
+		return nil, newLoadError(ErrInvalidValue, bqField, "unknown case %q for oneof %s", name, oneof.FullName())
+	}
+	return field, nil
+}
+
+// discriminatedOneof returns the non-synthetic oneof named after the column, if any.
+func discriminatedOneof(message protoreflect.MessageDescriptor, column string) protoreflect.OneofDescriptor {
+	oneof := message.Oneofs().ByName(protoreflect.Name(column))
+	if oneof == nil || oneof.IsSynthetic() {
+		return nil
+	}
+	return oneof
+}
+
+// skip reports whether the column of the oneof member should be skipped.
+func (l *oneofLoader) skip(bqField bigquery.Value, field protoreflect.FieldDescriptor) (bool, error) {
+	if l == nil {
+		return false, nil
+	}
+	oneof := field.ContainingOneof()
+	if oneof == nil || oneof.IsSynthetic() {
+		return false, nil
+	}
+	if oneofCase, ok := l.cases[oneof]; ok {
+		return oneofCase != field, nil
+	}
+	if bqField == nil {
+		return false, nil
+	}
+	if loaded, ok := l.loaded[oneof]; ok {
+		if l.mode == OneofStrict {
+			return false, newLoadError(
+				ErrOneofConflict, bqField, "oneof %s has multiple non-null members: %s and %s", oneof.Name(), loaded.Name(), field.Name(),
+			)
+		}
+		return true, nil
+	}
+	if l.loaded == nil {
+		l.loaded = make(map[protoreflect.OneofDescriptor]protoreflect.FieldDescriptor)
+	}
+	l.loaded[oneof] = field
+	return false, nil
+}
+
+// setCases sets the active cases without a non-null column to their default value.
+// If clearNull is set, oneofs without active case are cleared.
+func (l *oneofLoader) setCases(message protoreflect.Message, clearNull bool) {
+	if l == nil {
+		return
+	}
+	for oneof, oneofCase := range l.cases {
+		switch loaded := message.WhichOneof(oneof); {
+		case oneofCase == nil && loaded != nil && clearNull:
+			message.Clear(loaded)
+		case oneofCase != nil && loaded != oneofCase:
+			message.Set(oneofCase, message.NewField(oneofCase))
+		}
+	}
+}
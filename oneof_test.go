@@ -0,0 +1,142 @@
+package protobq
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMessageLoader_oneof(t *testing.T) {
+	membersSchema := bigquery.Schema{
+		{Name: "text", Type: bigquery.StringFieldType},
+		{Name: "string_option", Type: bigquery.StringFieldType},
+		{Name: "int_option", Type: bigquery.IntegerFieldType},
+		{Name: "bool_option", Type: bigquery.BooleanFieldType},
+	}
+	discriminatedSchema := append(bigquery.Schema{
+		{Name: "optional_value", Type: bigquery.StringFieldType},
+	}, membersSchema...)
+	for _, test := range []struct {
+		name          string
+		mode          OneofMode
+		row           []bigquery.Value
+		schema        bigquery.Schema
+		expected      func() proto.Message
+		expectedError error
+	}{
+		{
+			name:   "single member",
+			mode:   OneofStrict,
+			row:    []bigquery.Value{"t", nil, int64(1), nil},
+			schema: membersSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				result.SetIntOption(1)
+				return &result
+			},
+		},
+		{
+			name:   "lenient picks first",
+			mode:   OneofLenient,
+			row:    []bigquery.Value{"t", nil, int64(1), true},
+			schema: membersSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				result.SetIntOption(1)
+				return &result
+			},
+		},
+		{
+			name:          "strict conflict",
+			mode:          OneofStrict,
+			row:           []bigquery.Value{"t", nil, int64(1), true},
+			schema:        membersSchema,
+			expectedError: ErrOneofConflict,
+		},
+		{
+			name:   "discriminator",
+			mode:   OneofStrict,
+			row:    []bigquery.Value{"bool_option", "t", "ignored", int64(1), true},
+			schema: discriminatedSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				result.SetBoolOption(true)
+				return &result
+			},
+		},
+		{
+			name:   "discriminator with null member",
+			row:    []bigquery.Value{"string_option", "t", nil, int64(1), nil},
+			schema: discriminatedSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				result.SetStringOption("")
+				return &result
+			},
+		},
+		{
+			name:   "null discriminator",
+			row:    []bigquery.Value{nil, "t", "ignored", int64(1), true},
+			schema: discriminatedSchema,
+			expected: func() proto.Message {
+				var result testdatav1.NestedMessage
+				result.SetText("t")
+				return &result
+			},
+		},
+		{
+			name:          "unknown discriminator case",
+			row:           []bigquery.Value{"text", "t", nil, nil, nil},
+			schema:        discriminatedSchema,
+			expectedError: ErrInvalidValue,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var message testdatav1.NestedMessage
+			loader := MessageLoader{OneofMode: test.mode, Message: &message}
+			err := loader.Load(test.row, test.schema)
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Fatalf("expected error %v, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected(), &message, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMessageLoader_oneofConflictPath(t *testing.T) {
+	loader := MessageLoader{OneofMode: OneofStrict, Message: &testdatav1.KitchenSink{}}
+	err := loader.Load(
+		[]bigquery.Value{[]bigquery.Value{"a", int64(1)}},
+		bigquery.Schema{
+			{
+				Name: "nested_message",
+				Type: bigquery.RecordFieldType,
+				Schema: bigquery.Schema{
+					{Name: "string_option", Type: bigquery.StringFieldType},
+					{Name: "int_option", Type: bigquery.IntegerFieldType},
+				},
+			},
+		},
+	)
+	if err == nil || !strings.HasPrefix(err.Error(), "nested_message.int_option: oneof optional_value") {
+		t.Errorf("expected oneof conflict at nested_message.int_option, got %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package protobq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// Limits of the parts of BigQuery INTERVAL values.
+const (
+	maxIntervalMonths  = 10000 * 12
+	maxIntervalDays    = 3660000
+	maxIntervalSeconds = 87840000 * secondsPerHour
+)
+
+// ParseInterval parses an INTERVAL value in the canonical BigQuery format or as an ISO 8601 duration.
+//
+// The canonical format is "Y-M D H:M:S[.F]", where each of the year-month, day and time parts
+// has its own optional sign, e.g. "1-2 3 -4:5:6.789". Parts may be omitted, as in "1-2",
+// "3 4:5:6" or "4:5:6", but not reordered.
+//
+// ISO 8601 durations have the form "P[nY][nM][nW][nD][T[nH][nM][nS]]", with an optional sign
+// for the whole duration and for each component, e.g. "-P1Y2M" or "PT1H-30M". Weeks are
+// converted to 7 days. Only seconds may have a fractional part, separated by a period or a comma.
+//
+// Fractional seconds have at most 9 digits, and values must be within the range of BigQuery
+// INTERVAL values: 10000 years, 3660000 days and 87840000 hours in either direction.
+// The parts are returned as given, without canonicalization.
+func ParseInterval(s string) (*bigquery.IntervalValue, error) {
+	var interval *bigquery.IntervalValue
+	var err error
+	if strings.HasPrefix(strings.TrimPrefix(s, "-"), "P") {
+		interval, err = parseISO8601Interval(s)
+	} else {
+		interval, err = parseCanonicalInterval(s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid INTERVAL %q: %w", s, err)
+	}
+	if err := checkIntervalRange(interval); err != nil {
+		return nil, fmt.Errorf("invalid INTERVAL %q: %w", s, err)
+	}
+	return interval, nil
+}
+
+// parseCanonicalInterval parses the canonical BigQuery format "Y-M D H:M:S[.F]".
+func parseCanonicalInterval(s string) (*bigquery.IntervalValue, error) {
+	parts := strings.Split(s, " ")
+	if len(parts) > 3 {
+		return nil, errors.New("expected at most 3 parts")
+	}
+	var interval bigquery.IntervalValue
+	// Each part is identified by its separator, and must follow the previous part.
+	const (
+		yearMonthPart = iota
+		dayPart
+		timePart
+	)
+	next := yearMonthPart
+	for _, part := range parts {
+		sign, unsigned := intervalSign(part)
+		switch {
+		case strings.Contains(unsigned, ":"):
+			if next > timePart {
+				return nil, fmt.Errorf("unexpected part %q", part)
+			}
+			if err := parseIntervalTime(sign, unsigned, &interval); err != nil {
+				return nil, err
+			}
+			next = timePart + 1
+		case strings.Contains(unsigned, "-"):
+			if next > yearMonthPart {
+				return nil, fmt.Errorf("unexpected part %q", part)
+			}
+			years, months, ok := strings.Cut(unsigned, "-")
+			if !ok {
+				return nil, fmt.Errorf("invalid year-month part %q", part)
+			}
+			var err error
+			if interval.Years, err = parseIntervalNumber(sign, years); err != nil {
+				return nil, fmt.Errorf("invalid years in %q: %w", part, err)
+			}
+			if interval.Months, err = parseIntervalNumber(sign, months); err != nil {
+				return nil, fmt.Errorf("invalid months in %q: %w", part, err)
+			}
+			next = dayPart
+		default:
+			if next > dayPart {
+				return nil, fmt.Errorf("unexpected part %q", part)
+			}
+			var err error
+			if interval.Days, err = parseIntervalNumber(sign, unsigned); err != nil {
+				return nil, fmt.Errorf("invalid days in %q: %w", part, err)
+			}
+			next = timePart
+		}
+	}
+	return &interval, nil
+}
+
+// parseIntervalTime parses the time part "H:M:S[.F]" of the canonical format.
+func parseIntervalTime(sign int32, s string, interval *bigquery.IntervalValue) error {
+	fields := strings.Split(s, ":")
+	if len(fields) != 3 {
+		return fmt.Errorf("invalid time part %q: expected H:M:S", s)
+	}
+	var err error
+	if interval.Hours, err = parseIntervalNumber(sign, fields[0]); err != nil {
+		return fmt.Errorf("invalid hours in %q: %w", s, err)
+	}
+	if interval.Minutes, err = parseIntervalNumber(sign, fields[1]); err != nil {
+		return fmt.Errorf("invalid minutes in %q: %w", s, err)
+	}
+	if interval.Seconds, interval.SubSecondNanos, err = parseIntervalSeconds(sign, fields[2]); err != nil {
+		return fmt.Errorf("invalid seconds in %q: %w", s, err)
+	}
+	return nil
+}
+
+// parseISO8601Interval parses an ISO 8601 duration "P[nY][nM][nW][nD][T[nH][nM][nS]]".
+func parseISO8601Interval(s string) (*bigquery.IntervalValue, error) {
+	sign, unsigned := intervalSign(s)
+	rest, ok := strings.CutPrefix(unsigned, "P")
+	if !ok {
+		return nil, errors.New("expected ISO 8601 duration to start with P")
+	}
+	if rest == "" {
+		return nil, errors.New("expected ISO 8601 duration components")
+	}
+	var interval bigquery.IntervalValue
+	var weeks, days int32
+	// Designators of the date and time components, in the order they must appear.
+	designators := "YMWD"
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return nil, errors.New("unexpected T in ISO 8601 duration")
+			}
+			inTime, designators, rest = true, "HMS", rest[1:]
+			continue
+		}
+		end := strings.IndexAny(rest, "YMWDHS")
+		if end < 0 {
+			return nil, fmt.Errorf("missing designator after %q", rest)
+		}
+		number, designator := rest[:end], rest[end]
+		rest = rest[end+1:]
+		i := strings.IndexByte(designators, designator)
+		if i < 0 {
+			return nil, fmt.Errorf("unexpected designator %c", designator)
+		}
+		designators = designators[i+1:]
+		componentSign, unsignedNumber := intervalSign(number)
+		componentSign *= sign
+		var err error
+		switch {
+		case inTime && designator == 'S':
+			interval.Seconds, interval.SubSecondNanos, err = parseIntervalSeconds(componentSign, unsignedNumber)
+		case inTime && designator == 'H':
+			interval.Hours, err = parseIntervalNumber(componentSign, unsignedNumber)
+		case inTime && designator == 'M':
+			interval.Minutes, err = parseIntervalNumber(componentSign, unsignedNumber)
+		case designator == 'Y':
+			interval.Years, err = parseIntervalNumber(componentSign, unsignedNumber)
+		case designator == 'M':
+			interval.Months, err = parseIntervalNumber(componentSign, unsignedNumber)
+		case designator == 'W':
+			weeks, err = parseIntervalNumber(componentSign, unsignedNumber)
+		case designator == 'D':
+			days, err = parseIntervalNumber(componentSign, unsignedNumber)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %c component %q: %w", designator, number, err)
+		}
+	}
+	totalDays := 7*int64(weeks) + int64(days)
+	if totalDays < -maxIntervalDays || totalDays > maxIntervalDays {
+		return nil, fmt.Errorf("%d days out of range", totalDays)
+	}
+	interval.Days = int32(totalDays)
+	return &interval, nil
+}
+
+// intervalSign returns the sign of a number and the number without the sign.
+func intervalSign(s string) (int32, string) {
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		return -1, rest
+	}
+	return 1, s
+}
+
+// parseIntervalNumber parses an unsigned decimal number and applies the sign.
+func parseIntervalNumber(sign int32, s string) (int32, error) {
+	if s == "" {
+		return 0, errors.New("missing digits")
+	}
+	var n int64
+	for _, c := range []byte(s) {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("unexpected character %q", c)
+		}
+		n = 10*n + int64(c-'0')
+		if n > math.MaxInt32 {
+			return 0, errors.New("out of range")
+		}
+	}
+	return sign * int32(n), nil
+}
+
+// parseIntervalSeconds parses unsigned seconds with up to 9 fractional digits and applies the sign.
+func parseIntervalSeconds(sign int32, s string) (int32, int32, error) {
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if !hasFraction {
+		whole, fraction, hasFraction = strings.Cut(s, ",")
+	}
+	seconds, err := parseIntervalNumber(sign, whole)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !hasFraction {
+		return seconds, 0, nil
+	}
+	if fraction == "" || len(fraction) > 9 {
+		return 0, 0, errors.New("expected 1 to 9 fractional digits")
+	}
+	nanos, err := parseIntervalNumber(sign, fraction+strings.Repeat("0", 9-len(fraction)))
+	if err != nil {
+		return 0, 0, err
+	}
+	return seconds, nanos, nil
+}
+
+// checkIntervalRange checks that the interval is within the range of BigQuery INTERVAL values.
+func checkIntervalRange(interval *bigquery.IntervalValue) error {
+	parts := newIntervalParts(interval)
+	switch {
+	case parts.months < -maxIntervalMonths || parts.months > maxIntervalMonths:
+		return errors.New("year-month part out of range")
+	case parts.days < -maxIntervalDays || parts.days > maxIntervalDays:
+		return errors.New("day part out of range")
+	case parts.seconds < -maxIntervalSeconds || parts.seconds > maxIntervalSeconds ||
+		(parts.seconds == maxIntervalSeconds && parts.nanos > 0) ||
+		(parts.seconds == -maxIntervalSeconds && parts.nanos < 0):
+		return errors.New("time part out of range")
+	default:
+		return nil
+	}
+}
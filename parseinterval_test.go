@@ -0,0 +1,136 @@
+package protobq
+
+import (
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseInterval(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected *bigquery.IntervalValue
+	}{
+		{input: "1-2 3 4:5:6.789", expected: &bigquery.IntervalValue{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6, SubSecondNanos: 789000000}},
+		{input: "-1-2 3 -4:5:6.5", expected: &bigquery.IntervalValue{Years: -1, Months: -2, Days: 3, Hours: -4, Minutes: -5, Seconds: -6, SubSecondNanos: -500000000}},
+		{input: "0-0 -3 0:0:0", expected: &bigquery.IntervalValue{Days: -3}},
+		{input: "1-2", expected: &bigquery.IntervalValue{Years: 1, Months: 2}},
+		{input: "1-2 3", expected: &bigquery.IntervalValue{Years: 1, Months: 2, Days: 3}},
+		{input: "3 4:5:6", expected: &bigquery.IntervalValue{Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{input: "2:15:30.500", expected: &bigquery.IntervalValue{Hours: 2, Minutes: 15, Seconds: 30, SubSecondNanos: 500000000}},
+		{input: "-0:0:0.000000001", expected: &bigquery.IntervalValue{SubSecondNanos: -1}},
+		{input: "7", expected: &bigquery.IntervalValue{Days: 7}},
+		{input: "10000-0", expected: &bigquery.IntervalValue{Years: 10000}},
+		{input: "-87840000:0:0", expected: &bigquery.IntervalValue{Hours: -87840000}},
+		{input: "PT1H30M45.123S", expected: &bigquery.IntervalValue{Hours: 1, Minutes: 30, Seconds: 45, SubSecondNanos: 123000000}},
+		{input: "P1Y2M3DT4H5M6S", expected: &bigquery.IntervalValue{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{input: "P2W1D", expected: &bigquery.IntervalValue{Days: 15}},
+		{input: "P1M", expected: &bigquery.IntervalValue{Months: 1}},
+		{input: "PT1M", expected: &bigquery.IntervalValue{Minutes: 1}},
+		{input: "PT0,25S", expected: &bigquery.IntervalValue{SubSecondNanos: 250000000}},
+		{input: "-PT30S", expected: &bigquery.IntervalValue{Seconds: -30}},
+		{input: "-P1Y-2M", expected: &bigquery.IntervalValue{Years: -1, Months: 2}},
+		{input: "PT1H-30M", expected: &bigquery.IntervalValue{Hours: 1, Minutes: -30}},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			actual, err := ParseInterval(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("unexpected interval (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseInterval_errors(t *testing.T) {
+	for _, input := range []string{
+		"",
+		" ",
+		"1 day",
+		"1-30-45",
+		"XX:30:45",
+		"1:XX:45",
+		"1:30:XX",
+		"1:30",
+		"1:2:3 4",
+		"3 1-2",
+		"1-2 3 4:5:6 7",
+		"+1-2",
+		"--1-2",
+		"1--2",
+		"1:2:3.",
+		"1:2:3.1234567890",
+		"10000-1",
+		"3660001",
+		"87840000:0:0.000000001",
+		"99999999999",
+		"P",
+		"PT",
+		"P1YT",
+		"PT1H30M45.XXXS",
+		"PTXXH30M45S",
+		"PT1H30MXXS",
+		"P1D1Y",
+		"PT1S1M",
+		"P1H",
+		"PT1D",
+		"P1.5D",
+		"P1",
+		"P1YTT1H",
+		"--P1Y",
+		"P--1Y",
+		"P522858W7D",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if actual, err := ParseInterval(input); err == nil {
+				t.Errorf("expected error, got %v", actual)
+			}
+		})
+	}
+}
+
+func FuzzParseInterval(f *testing.F) {
+	for _, seed := range []string{
+		"1-2 3 4:5:6.789",
+		"-1-2 -3 -4:5:6.5",
+		"3 4:5:6",
+		"0:0:0.000000001",
+		"P1Y2M3W4DT5H6M7.89S",
+		"-PT30S",
+		"PT1H-30M",
+		"P1M",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		interval, err := ParseInterval(input)
+		if err != nil {
+			return
+		}
+		// Each part keeps its own sign in ISO 8601 format, so the interval survives a round trip.
+		formatted := formatISO8601Interval(interval)
+		actual, err := ParseInterval(formatted)
+		if err != nil {
+			t.Fatalf("%q parsed as %q, which does not parse: %v", input, formatted, err)
+		}
+		if diff := cmp.Diff(interval, actual); diff != "" {
+			t.Errorf("%q parsed as %q, which parses differently (-expected +actual):\n%s", input, formatted, diff)
+		}
+	})
+}
+
+func formatISO8601Interval(interval *bigquery.IntervalValue) string {
+	seconds, nanos := interval.Seconds, interval.SubSecondNanos
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign, seconds, nanos = "-", -seconds, -nanos
+	}
+	return fmt.Sprintf(
+		"P%dY%dM%dDT%dH%dM%s%d.%09dS",
+		interval.Years, interval.Months, interval.Days, interval.Hours, interval.Minutes, sign, seconds, nanos,
+	)
+}
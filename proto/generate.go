@@ -2,3 +2,4 @@ package proto
 
 //go:generate go tool -modfile ../tools/go.mod buf format -w
 //go:generate go tool -modfile ../tools/go.mod buf generate --path wayplatform
+//go:generate go tool -modfile ../tools/go.mod buf generate --template buf.gen.protobq.yaml --path protobq
@@ -0,0 +1,478 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: protobq/v1/annotations.proto
+
+package protobqpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Units of integers holding timestamps, durations and dates, since the Unix epoch for timestamps and dates.
+type EpochUnit int32
+
+const (
+	// Default unit of the field type.
+	EpochUnit_EPOCH_UNIT_UNSPECIFIED  EpochUnit = 0
+	EpochUnit_EPOCH_UNIT_SECONDS      EpochUnit = 1
+	EpochUnit_EPOCH_UNIT_MILLISECONDS EpochUnit = 2
+	EpochUnit_EPOCH_UNIT_MICROSECONDS EpochUnit = 3
+	EpochUnit_EPOCH_UNIT_NANOSECONDS  EpochUnit = 4
+	EpochUnit_EPOCH_UNIT_DAYS         EpochUnit = 5
+)
+
+// Enum value maps for EpochUnit.
+var (
+	EpochUnit_name = map[int32]string{
+		0: "EPOCH_UNIT_UNSPECIFIED",
+		1: "EPOCH_UNIT_SECONDS",
+		2: "EPOCH_UNIT_MILLISECONDS",
+		3: "EPOCH_UNIT_MICROSECONDS",
+		4: "EPOCH_UNIT_NANOSECONDS",
+		5: "EPOCH_UNIT_DAYS",
+	}
+	EpochUnit_value = map[string]int32{
+		"EPOCH_UNIT_UNSPECIFIED":  0,
+		"EPOCH_UNIT_SECONDS":      1,
+		"EPOCH_UNIT_MILLISECONDS": 2,
+		"EPOCH_UNIT_MICROSECONDS": 3,
+		"EPOCH_UNIT_NANOSECONDS":  4,
+		"EPOCH_UNIT_DAYS":         5,
+	}
+)
+
+func (x EpochUnit) Enum() *EpochUnit {
+	p := new(EpochUnit)
+	*p = x
+	return p
+}
+
+func (x EpochUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EpochUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobq_v1_annotations_proto_enumTypes[0].Descriptor()
+}
+
+func (EpochUnit) Type() protoreflect.EnumType {
+	return &file_protobq_v1_annotations_proto_enumTypes[0]
+}
+
+func (x EpochUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Geometry types of GEOGRAPHY values loaded into messages.
+type GeometryType int32
+
+const (
+	// Geometry type identified by the shape of the message.
+	GeometryType_GEOMETRY_TYPE_UNSPECIFIED GeometryType = 0
+	// LINESTRING, loaded into a message with a repeated google.type.LatLng field.
+	GeometryType_GEOMETRY_TYPE_LINE_STRING GeometryType = 1
+	// POLYGON, loaded into a message with a repeated field of rings,
+	// each a message with a repeated google.type.LatLng field.
+	GeometryType_GEOMETRY_TYPE_POLYGON GeometryType = 2
+	// MULTIPOINT, loaded into a message with a repeated google.type.LatLng field.
+	GeometryType_GEOMETRY_TYPE_MULTI_POINT GeometryType = 3
+)
+
+// Enum value maps for GeometryType.
+var (
+	GeometryType_name = map[int32]string{
+		0: "GEOMETRY_TYPE_UNSPECIFIED",
+		1: "GEOMETRY_TYPE_LINE_STRING",
+		2: "GEOMETRY_TYPE_POLYGON",
+		3: "GEOMETRY_TYPE_MULTI_POINT",
+	}
+	GeometryType_value = map[string]int32{
+		"GEOMETRY_TYPE_UNSPECIFIED": 0,
+		"GEOMETRY_TYPE_LINE_STRING": 1,
+		"GEOMETRY_TYPE_POLYGON":     2,
+		"GEOMETRY_TYPE_MULTI_POINT": 3,
+	}
+)
+
+func (x GeometryType) Enum() *GeometryType {
+	p := new(GeometryType)
+	*p = x
+	return p
+}
+
+func (x GeometryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GeometryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobq_v1_annotations_proto_enumTypes[1].Descriptor()
+}
+
+func (GeometryType) Type() protoreflect.EnumType {
+	return &file_protobq_v1_annotations_proto_enumTypes[1]
+}
+
+func (x GeometryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Field options for mapping a field to a BigQuery column.
+type FieldOptions struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Column         *string                `protobuf:"bytes,1,opt,name=column"`
+	xxx_hidden_CurrencyCode   *string                `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode"`
+	xxx_hidden_CurrencyColumn *string                `protobuf:"bytes,3,opt,name=currency_column,json=currencyColumn"`
+	xxx_hidden_Geometry       GeometryType           `protobuf:"varint,4,opt,name=geometry,enum=protobq.v1.GeometryType"`
+	xxx_hidden_EpochUnit      EpochUnit              `protobuf:"varint,5,opt,name=epoch_unit,json=epochUnit,enum=protobq.v1.EpochUnit"`
+	xxx_hidden_UnknownColumns bool                   `protobuf:"varint,6,opt,name=unknown_columns,json=unknownColumns"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *FieldOptions) Reset() {
+	*x = FieldOptions{}
+	mi := &file_protobq_v1_annotations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOptions) ProtoMessage() {}
+
+func (x *FieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_protobq_v1_annotations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FieldOptions) GetColumn() string {
+	if x != nil {
+		if x.xxx_hidden_Column != nil {
+			return *x.xxx_hidden_Column
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetCurrencyCode() string {
+	if x != nil {
+		if x.xxx_hidden_CurrencyCode != nil {
+			return *x.xxx_hidden_CurrencyCode
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetCurrencyColumn() string {
+	if x != nil {
+		if x.xxx_hidden_CurrencyColumn != nil {
+			return *x.xxx_hidden_CurrencyColumn
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetGeometry() GeometryType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Geometry
+		}
+	}
+	return GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+}
+
+func (x *FieldOptions) GetEpochUnit() EpochUnit {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_EpochUnit
+		}
+	}
+	return EpochUnit_EPOCH_UNIT_UNSPECIFIED
+}
+
+func (x *FieldOptions) GetUnknownColumns() bool {
+	if x != nil {
+		return x.xxx_hidden_UnknownColumns
+	}
+	return false
+}
+
+func (x *FieldOptions) SetColumn(v string) {
+	x.xxx_hidden_Column = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *FieldOptions) SetCurrencyCode(v string) {
+	x.xxx_hidden_CurrencyCode = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *FieldOptions) SetCurrencyColumn(v string) {
+	x.xxx_hidden_CurrencyColumn = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *FieldOptions) SetGeometry(v GeometryType) {
+	x.xxx_hidden_Geometry = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *FieldOptions) SetEpochUnit(v EpochUnit) {
+	x.xxx_hidden_EpochUnit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *FieldOptions) SetUnknownColumns(v bool) {
+	x.xxx_hidden_UnknownColumns = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *FieldOptions) HasColumn() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FieldOptions) HasCurrencyCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FieldOptions) HasCurrencyColumn() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FieldOptions) HasGeometry() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FieldOptions) HasEpochUnit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *FieldOptions) HasUnknownColumns() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *FieldOptions) ClearColumn() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Column = nil
+}
+
+func (x *FieldOptions) ClearCurrencyCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CurrencyCode = nil
+}
+
+func (x *FieldOptions) ClearCurrencyColumn() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CurrencyColumn = nil
+}
+
+func (x *FieldOptions) ClearGeometry() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Geometry = GeometryType_GEOMETRY_TYPE_UNSPECIFIED
+}
+
+func (x *FieldOptions) ClearEpochUnit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_EpochUnit = EpochUnit_EPOCH_UNIT_UNSPECIFIED
+}
+
+func (x *FieldOptions) ClearUnknownColumns() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_UnknownColumns = false
+}
+
+type FieldOptions_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the BigQuery column of the field.
+	Column *string
+	// Currency code of a google.type.Money field loaded from a NUMERIC or BIGNUMERIC column, e.g. "EUR".
+	CurrencyCode *string
+	// Name of the STRING column holding the currency code of a google.type.Money field,
+	// loaded from a NUMERIC or BIGNUMERIC column. Takes precedence over currency_code.
+	CurrencyColumn *string
+	// Geometry type of a message field loaded from a GEOGRAPHY column.
+	// Identifies the geometry held by messages without the well-known shape of geometry messages.
+	Geometry *GeometryType
+	// Unit of integers holding timestamps, durations and dates, e.g. a google.protobuf.Timestamp
+	// loaded from an INTEGER column of milliseconds, or an int64 field loaded from a TIMESTAMP column.
+	// Takes precedence over the epoch unit of the loader.
+	EpochUnit *EpochUnit
+	// Stores the columns of the message without a corresponding field in this google.protobuf.Struct
+	// or map<string, string> field, keyed by column name. Each column is stored as a JSON object
+	// holding its BigQuery "type", "repeated" for REPEATED columns, and "value".
+	UnknownColumns *bool
+}
+
+func (b0 FieldOptions_builder) Build() *FieldOptions {
+	m0 := &FieldOptions{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Column != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Column = b.Column
+	}
+	if b.CurrencyCode != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_CurrencyCode = b.CurrencyCode
+	}
+	if b.CurrencyColumn != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_CurrencyColumn = b.CurrencyColumn
+	}
+	if b.Geometry != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Geometry = *b.Geometry
+	}
+	if b.EpochUnit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_EpochUnit = *b.EpochUnit
+	}
+	if b.UnknownColumns != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_UnknownColumns = *b.UnknownColumns
+	}
+	return m0
+}
+
+var file_protobq_v1_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldOptions)(nil),
+		Field:         51234,
+		Name:          "protobq.v1.field",
+		Tag:           "bytes,51234,opt,name=field",
+		Filename:      "protobq/v1/annotations.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// BigQuery options of the field, e.g. [(protobq.v1.field).column = "user_id"].
+	//
+	// 51234 is in the range reserved for private use (50000-99999) and may collide with the options
+	// of other libraries. It is replaced by the number reserved for protobq in the global extension
+	// registry, https://github.com/protocolbuffers/protobuf/blob/main/docs/options.md, once assigned.
+	//
+	// optional protobq.v1.FieldOptions field = 51234;
+	E_Field = &file_protobq_v1_annotations_proto_extTypes[0]
+)
+
+var File_protobq_v1_annotations_proto protoreflect.FileDescriptor
+
+const file_protobq_v1_annotations_proto_rawDesc = "" +
+	"\n" +
+	"\x1cprotobq/v1/annotations.proto\x12\n" +
+	"protobq.v1\x1a google/protobuf/descriptor.proto\"\x89\x02\n" +
+	"\fFieldOptions\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12'\n" +
+	"\x0fcurrency_column\x18\x03 \x01(\tR\x0ecurrencyColumn\x124\n" +
+	"\bgeometry\x18\x04 \x01(\x0e2\x18.protobq.v1.GeometryTypeR\bgeometry\x124\n" +
+	"\n" +
+	"epoch_unit\x18\x05 \x01(\x0e2\x15.protobq.v1.EpochUnitR\tepochUnit\x12'\n" +
+	"\x0funknown_columns\x18\x06 \x01(\bR\x0eunknownColumns*\xaa\x01\n" +
+	"\tEpochUnit\x12\x1a\n" +
+	"\x16EPOCH_UNIT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EPOCH_UNIT_SECONDS\x10\x01\x12\x1b\n" +
+	"\x17EPOCH_UNIT_MILLISECONDS\x10\x02\x12\x1b\n" +
+	"\x17EPOCH_UNIT_MICROSECONDS\x10\x03\x12\x1a\n" +
+	"\x16EPOCH_UNIT_NANOSECONDS\x10\x04\x12\x13\n" +
+	"\x0fEPOCH_UNIT_DAYS\x10\x05*\x86\x01\n" +
+	"\fGeometryType\x12\x1d\n" +
+	"\x19GEOMETRY_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19GEOMETRY_TYPE_LINE_STRING\x10\x01\x12\x19\n" +
+	"\x15GEOMETRY_TYPE_POLYGON\x10\x02\x12\x1d\n" +
+	"\x19GEOMETRY_TYPE_MULTI_POINT\x10\x03:O\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xa2\x90\x03 \x01(\v2\x18.protobq.v1.FieldOptionsR\x05fieldB.Z,github.com/way-platform/protobq-go/protobqpbb\beditionsp\xe8\a"
+
+var file_protobq_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protobq_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_protobq_v1_annotations_proto_goTypes = []any{
+	(EpochUnit)(0),                    // 0: protobq.v1.EpochUnit
+	(GeometryType)(0),                 // 1: protobq.v1.GeometryType
+	(*FieldOptions)(nil),              // 2: protobq.v1.FieldOptions
+	(*descriptorpb.FieldOptions)(nil), // 3: google.protobuf.FieldOptions
+}
+var file_protobq_v1_annotations_proto_depIdxs = []int32{
+	1, // 0: protobq.v1.FieldOptions.geometry:type_name -> protobq.v1.GeometryType
+	0, // 1: protobq.v1.FieldOptions.epoch_unit:type_name -> protobq.v1.EpochUnit
+	3, // 2: protobq.v1.field:extendee -> google.protobuf.FieldOptions
+	2, // 3: protobq.v1.field:type_name -> protobq.v1.FieldOptions
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	3, // [3:4] is the sub-list for extension type_name
+	2, // [2:3] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protobq_v1_annotations_proto_init() }
+func file_protobq_v1_annotations_proto_init() {
+	if File_protobq_v1_annotations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protobq_v1_annotations_proto_rawDesc), len(file_protobq_v1_annotations_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_protobq_v1_annotations_proto_goTypes,
+		DependencyIndexes: file_protobq_v1_annotations_proto_depIdxs,
+		EnumInfos:         file_protobq_v1_annotations_proto_enumTypes,
+		MessageInfos:      file_protobq_v1_annotations_proto_msgTypes,
+		ExtensionInfos:    file_protobq_v1_annotations_proto_extTypes,
+	}.Build()
+	File_protobq_v1_annotations_proto = out.File
+	file_protobq_v1_annotations_proto_goTypes = nil
+	file_protobq_v1_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,244 @@
+package protobq
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/way-platform/protobq-go/protobqpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxNestingDepth is the maximum nesting depth of RECORD fields supported by BigQuery.
+const maxNestingDepth = 15
+
+// SchemaOption configures SchemaFor.
+type SchemaOption func(*schemaOptions)
+
+type schemaOptions struct {
+	maxRecursionDepth int
+}
+
+// WithMaxRecursionDepth allows recursive messages to be unrolled up to the given depth.
+// Recursive fields beyond the given depth are omitted from the schema.
+// By default, recursive messages are not supported and SchemaFor returns an error.
+func WithMaxRecursionDepth(depth int) SchemaOption {
+	return func(opts *schemaOptions) {
+		opts.maxRecursionDepth = depth
+	}
+}
+
+// SchemaFor returns the bigquery.Schema that MessageLoader expects when loading rows into
+// messages of the given descriptor.
+//
+// Columns are named after the field option (protobq.v1.field).column, or the proto name of the field.
+// Decimal types are NUMERIC columns, with a STRING column for the currency set by the field option
+// (protobq.v1.field).currency_column, interval messages are INTERVAL columns, and geometry messages
+// are GEOGRAPHY columns. Fields with the option (protobq.v1.field).epoch_unit are INTEGER columns,
+// and the field storing unknown columns has no column.
+func SchemaFor(message protoreflect.MessageDescriptor, opts ...SchemaOption) (bigquery.Schema, error) {
+	var options schemaOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	g := schemaGenerator{
+		options:    options,
+		recursions: map[protoreflect.FullName]int{message.FullName(): 1},
+	}
+	return g.messageSchema(message, 0)
+}
+
+type schemaGenerator struct {
+	options    schemaOptions
+	recursions map[protoreflect.FullName]int
+}
+
+func (g *schemaGenerator) messageSchema(message protoreflect.MessageDescriptor, depth int) (bigquery.Schema, error) {
+	if depth > maxNestingDepth {
+		return nil, fmt.Errorf("%s: exceeds maximum nesting depth of %d", message.FullName(), maxNestingDepth)
+	}
+	fields := message.Fields()
+	result := make(bigquery.Schema, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if fieldOptions(field).GetUnknownColumns() {
+			continue
+		}
+		fieldSchema, err := g.fieldSchema(field, depth)
+		if err != nil {
+			return nil, err
+		}
+		if fieldSchema != nil {
+			result = append(result, fieldSchema)
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		name, ok := currencyColumnOption(fields.Get(i))
+		if ok && !slices.ContainsFunc(result, func(fieldSchema *bigquery.FieldSchema) bool { return fieldSchema.Name == name }) {
+			result = append(result, &bigquery.FieldSchema{Name: name, Type: bigquery.StringFieldType})
+		}
+	}
+	return result, nil
+}
+
+func (g *schemaGenerator) fieldSchema(field protoreflect.FieldDescriptor, depth int) (*bigquery.FieldSchema, error) {
+	if field.IsMap() {
+		keySchema, err := g.singularFieldSchema(field.MapKey(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		valueSchema, err := g.singularFieldSchema(field.MapValue(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if valueSchema == nil {
+			return nil, nil
+		}
+		return &bigquery.FieldSchema{
+			Name:     columnName(field),
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema:   bigquery.Schema{keySchema, valueSchema},
+		}, nil
+	}
+	result, err := g.singularFieldSchema(field, depth)
+	if err != nil || result == nil {
+		return nil, err
+	}
+	result.Repeated = field.IsList()
+	result.Required = field.Cardinality() == protoreflect.Required
+	return result, nil
+}
+
+func (g *schemaGenerator) singularFieldSchema(field protoreflect.FieldDescriptor, depth int) (*bigquery.FieldSchema, error) {
+	result := &bigquery.FieldSchema{
+		Name: columnName(field),
+	}
+	if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+		fieldType, err := scalarFieldType(field)
+		if err != nil {
+			return nil, err
+		}
+		result.Type = fieldType
+		return result, nil
+	}
+	message := field.Message()
+	switch name := message.FullName(); {
+	case (name == wktTimestamp || name == wktDuration || name == wktDate) &&
+		fieldOptions(field).GetEpochUnit() != protobqpb.EpochUnit_EPOCH_UNIT_UNSPECIFIED:
+		result.Type = bigquery.IntegerFieldType
+		return result, nil
+	case isDecimalType(name):
+		result.Type = bigquery.NumericFieldType
+		return result, nil
+	case name == wktAny:
+		result.Type = bigquery.RecordFieldType
+		result.Schema = bigquery.Schema{
+			{Name: "type_url", Type: bigquery.StringFieldType},
+			{Name: "value", Type: bigquery.BytesFieldType},
+		}
+		return result, nil
+	}
+	if fieldType, ok := wellKnownTypeFieldType(message.FullName()); ok {
+		result.Type = fieldType
+		return result, nil
+	}
+	if isGeometryField(field) {
+		result.Type = bigquery.GeographyFieldType
+		return result, nil
+	}
+	if isIntervalMessage(message) {
+		result.Type = bigquery.IntervalFieldType
+		return result, nil
+	}
+	if isRangeMessage(message) {
+		result.Type = bigquery.RangeFieldType
+		result.RangeElementType = &bigquery.RangeElementType{Type: rangeElementFieldType(message)}
+		return result, nil
+	}
+	if g.recursions[message.FullName()] > g.options.maxRecursionDepth {
+		if g.options.maxRecursionDepth == 0 {
+			return nil, fmt.Errorf("%s: unsupported recursive message: %s", field.FullName(), message.FullName())
+		}
+		return nil, nil
+	}
+	g.recursions[message.FullName()]++
+	defer func() { g.recursions[message.FullName()]-- }()
+	schema, err := g.messageSchema(message, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	result.Type = bigquery.RecordFieldType
+	result.Schema = schema
+	return result, nil
+}
+
+func scalarFieldType(field protoreflect.FieldDescriptor) (bigquery.FieldType, error) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return bigquery.BooleanFieldType, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return bigquery.IntegerFieldType, nil
+	case protoreflect.EnumKind:
+		if field.Enum().FullName() == wktNullValue {
+			// JSON null, as loaded from a JSON column.
+			return bigquery.JSONFieldType, nil
+		}
+		return bigquery.IntegerFieldType, nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return bigquery.FloatFieldType, nil
+	case protoreflect.StringKind:
+		return bigquery.StringFieldType, nil
+	case protoreflect.BytesKind:
+		return bigquery.BytesFieldType, nil
+	default:
+		return "", fmt.Errorf("%s: unsupported kind: %v", field.FullName(), field.Kind())
+	}
+}
+
+func wellKnownTypeFieldType(name protoreflect.FullName) (bigquery.FieldType, bool) {
+	switch name {
+	case wktTimestamp:
+		return bigquery.TimestampFieldType, true
+	case wktDuration:
+		return bigquery.StringFieldType, true
+	case wktTimeOfDay:
+		return bigquery.TimeFieldType, true
+	case wktDate:
+		return bigquery.DateFieldType, true
+	case kwtDateTime:
+		return bigquery.DateTimeFieldType, true
+	case wktLatLng:
+		return bigquery.GeographyFieldType, true
+	case wktStruct, wktValue, wktListValue:
+		return bigquery.JSONFieldType, true
+	case wktDoubleValue, wktFloatValue:
+		return bigquery.FloatFieldType, true
+	case wktInt32Value, wktInt64Value, wktUInt32Value, wktUInt64Value:
+		return bigquery.IntegerFieldType, true
+	case wktBoolValue:
+		return bigquery.BooleanFieldType, true
+	case wktStringValue:
+		return bigquery.StringFieldType, true
+	case wktBytesValue:
+		return bigquery.BytesFieldType, true
+	default:
+		return "", false
+	}
+}
+
+// rangeElementFieldType returns the element type of a RANGE message.
+// The naming convention matches the one used when loading range values.
+func rangeElementFieldType(message protoreflect.MessageDescriptor) bigquery.FieldType {
+	if message.Fields().ByName("start").Kind() == protoreflect.MessageKind {
+		return bigquery.TimestampFieldType
+	}
+	if strings.Contains(string(message.FullName()), "DateRange") {
+		return bigquery.DateFieldType
+	}
+	return bigquery.DateTimeFieldType
+}
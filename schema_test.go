@@ -0,0 +1,382 @@
+package protobq
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSchemaFor(t *testing.T) {
+	schema, err := SchemaFor((&testdatav1.KitchenSink{}).ProtoReflect().Descriptor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name     string
+		expected *bigquery.FieldSchema
+	}{
+		{
+			name:     "double_value",
+			expected: &bigquery.FieldSchema{Name: "double_value", Type: bigquery.FloatFieldType},
+		},
+		{
+			name:     "uint64_value",
+			expected: &bigquery.FieldSchema{Name: "uint64_value", Type: bigquery.IntegerFieldType},
+		},
+		{
+			name:     "bytes_value",
+			expected: &bigquery.FieldSchema{Name: "bytes_value", Type: bigquery.BytesFieldType},
+		},
+		{
+			name:     "enum_value",
+			expected: &bigquery.FieldSchema{Name: "enum_value", Type: bigquery.IntegerFieldType},
+		},
+		{
+			name:     "repeated_string",
+			expected: &bigquery.FieldSchema{Name: "repeated_string", Type: bigquery.StringFieldType, Repeated: true},
+		},
+		{
+			name: "nested_message",
+			expected: &bigquery.FieldSchema{
+				Name: "nested_message",
+				Type: bigquery.RecordFieldType,
+				Schema: bigquery.Schema{
+					{Name: "text", Type: bigquery.StringFieldType},
+					{Name: "number", Type: bigquery.IntegerFieldType},
+					{Name: "flag", Type: bigquery.BooleanFieldType},
+					{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+					{Name: "string_option", Type: bigquery.StringFieldType},
+					{Name: "int_option", Type: bigquery.IntegerFieldType},
+					{Name: "bool_option", Type: bigquery.BooleanFieldType},
+					{Name: "timestamp_option", Type: bigquery.TimestampFieldType},
+					{
+						Name: "complex_option",
+						Type: bigquery.RecordFieldType,
+						Schema: bigquery.Schema{
+							{Name: "device_id", Type: bigquery.StringFieldType},
+							{Name: "labels", Type: bigquery.StringFieldType, Repeated: true},
+							{Name: "last_seen", Type: bigquery.TimestampFieldType},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "map_int32_string",
+			expected: &bigquery.FieldSchema{
+				Name:     "map_int32_string",
+				Type:     bigquery.RecordFieldType,
+				Repeated: true,
+				Schema: bigquery.Schema{
+					{Name: "key", Type: bigquery.IntegerFieldType},
+					{Name: "value", Type: bigquery.StringFieldType},
+				},
+			},
+		},
+		{
+			name:     "timestamp_value",
+			expected: &bigquery.FieldSchema{Name: "timestamp_value", Type: bigquery.TimestampFieldType},
+		},
+		{
+			name:     "datetime_value",
+			expected: &bigquery.FieldSchema{Name: "datetime_value", Type: bigquery.DateTimeFieldType},
+		},
+		{
+			name:     "latlng_value",
+			expected: &bigquery.FieldSchema{Name: "latlng_value", Type: bigquery.GeographyFieldType},
+		},
+		{
+			name:     "uint32_wrapper_value",
+			expected: &bigquery.FieldSchema{Name: "uint32_wrapper_value", Type: bigquery.IntegerFieldType},
+		},
+		{
+			name:     "repeated_timestamp",
+			expected: &bigquery.FieldSchema{Name: "repeated_timestamp", Type: bigquery.TimestampFieldType, Repeated: true},
+		},
+		{
+			name: "date_range",
+			expected: &bigquery.FieldSchema{
+				Name:             "date_range",
+				Type:             bigquery.RangeFieldType,
+				RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateFieldType},
+			},
+		},
+		{
+			name: "timestamp_range",
+			expected: &bigquery.FieldSchema{
+				Name:             "timestamp_range",
+				Type:             bigquery.RangeFieldType,
+				RangeElementType: &bigquery.RangeElementType{Type: bigquery.TimestampFieldType},
+			},
+		},
+		{
+			name: "datetime_range",
+			expected: &bigquery.FieldSchema{
+				Name:             "datetime_range",
+				Type:             bigquery.RangeFieldType,
+				RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateTimeFieldType},
+			},
+		},
+		{
+			name:     "null_value",
+			expected: &bigquery.FieldSchema{Name: "null_value", Type: bigquery.JSONFieldType},
+		},
+		{
+			name: "any_value",
+			expected: &bigquery.FieldSchema{
+				Name: "any_value",
+				Type: bigquery.RecordFieldType,
+				Schema: bigquery.Schema{
+					{Name: "type_url", Type: bigquery.StringFieldType},
+					{Name: "value", Type: bigquery.BytesFieldType},
+				},
+			},
+		},
+		{
+			name:     "fraction_value",
+			expected: &bigquery.FieldSchema{Name: "fraction_value", Type: bigquery.NumericFieldType},
+		},
+		{
+			name:     "price",
+			expected: &bigquery.FieldSchema{Name: "price", Type: bigquery.NumericFieldType},
+		},
+		{
+			name:     "price_currency",
+			expected: &bigquery.FieldSchema{Name: "price_currency", Type: bigquery.StringFieldType},
+		},
+		{
+			name: "map_string_decimal",
+			expected: &bigquery.FieldSchema{
+				Name:     "map_string_decimal",
+				Type:     bigquery.RecordFieldType,
+				Repeated: true,
+				Schema: bigquery.Schema{
+					{Name: "key", Type: bigquery.StringFieldType},
+					{Name: "value", Type: bigquery.NumericFieldType},
+				},
+			},
+		},
+		{
+			name:     "repeated_interval",
+			expected: &bigquery.FieldSchema{Name: "repeated_interval", Type: bigquery.IntervalFieldType, Repeated: true},
+		},
+		{
+			name:     "month_day_nanos",
+			expected: &bigquery.FieldSchema{Name: "month_day_nanos", Type: bigquery.IntervalFieldType},
+		},
+		{
+			name:     "polygon",
+			expected: &bigquery.FieldSchema{Name: "polygon", Type: bigquery.GeographyFieldType},
+		},
+		{
+			name:     "route",
+			expected: &bigquery.FieldSchema{Name: "route", Type: bigquery.GeographyFieldType},
+		},
+		{
+			name:     "timestamp_millis",
+			expected: &bigquery.FieldSchema{Name: "timestamp_millis", Type: bigquery.IntegerFieldType},
+		},
+		{
+			name:     "duration_millis",
+			expected: &bigquery.FieldSchema{Name: "duration_millis", Type: bigquery.IntegerFieldType},
+		},
+		{
+			name:     "duration_value",
+			expected: &bigquery.FieldSchema{Name: "duration_value", Type: bigquery.StringFieldType},
+		},
+		{
+			name:     "repeated_timestamp_nanos",
+			expected: &bigquery.FieldSchema{Name: "repeated_timestamp_nanos", Type: bigquery.IntegerFieldType, Repeated: true},
+		},
+		{
+			name: "map_string_date_range",
+			expected: &bigquery.FieldSchema{
+				Name:     "map_string_date_range",
+				Type:     bigquery.RecordFieldType,
+				Repeated: true,
+				Schema: bigquery.Schema{
+					{Name: "key", Type: bigquery.StringFieldType},
+					{
+						Name:             "value",
+						Type:             bigquery.RangeFieldType,
+						RangeElementType: &bigquery.RangeElementType{Type: bigquery.DateFieldType},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			actual := findFieldSchema(schema, test.name)
+			if actual == nil {
+				t.Fatalf("field %s not found in schema", test.name)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("unexpected field schema (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaFor_allFields(t *testing.T) {
+	descriptor := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	schema, err := SchemaFor(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Fields are followed by the currency column of the price field.
+	if len(schema) != descriptor.Fields().Len()+1 {
+		t.Fatalf("expected %d fields, got %d", descriptor.Fields().Len()+1, len(schema))
+	}
+	for i, fieldSchema := range schema[:len(schema)-1] {
+		if expected := string(descriptor.Fields().Get(i).Name()); fieldSchema.Name != expected {
+			t.Errorf("expected field %d to be %s, got %s", i, expected, fieldSchema.Name)
+		}
+	}
+	if name := schema[len(schema)-1].Name; name != "price_currency" {
+		t.Errorf("expected last field to be price_currency, got %s", name)
+	}
+}
+
+func TestSchemaFor_fieldOptions(t *testing.T) {
+	t.Run("column", func(t *testing.T) {
+		schema, err := SchemaFor((&testdatav1.RenamedFields{}).ProtoReflect().Descriptor())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := bigquery.Schema{
+			{Name: "display_name", Type: bigquery.StringFieldType},
+			{Name: "created_by", Type: bigquery.StringFieldType},
+			{Name: "uid", Type: bigquery.IntegerFieldType},
+		}
+		if diff := cmp.Diff(expected, schema); diff != "" {
+			t.Errorf("unexpected schema (-expected +actual):\n%s", diff)
+		}
+		loader := MessageLoader{Message: &testdatav1.RenamedFields{}}
+		if err := loader.Load([]bigquery.Value{"a", "b", int64(1)}, schema); err != nil {
+			t.Fatal(err)
+		}
+		var expectedMessage testdatav1.RenamedFields
+		expectedMessage.SetDisplayName("a")
+		expectedMessage.SetCreatedBy("b")
+		expectedMessage.SetUserId(1)
+		if diff := cmp.Diff(&expectedMessage, loader.Message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("unknown columns", func(t *testing.T) {
+		schema, err := SchemaFor((&testdatav1.UnknownColumnsMap{}).ProtoReflect().Descriptor())
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := bigquery.Schema{{Name: "id", Type: bigquery.StringFieldType}}
+		if diff := cmp.Diff(expected, schema); diff != "" {
+			t.Errorf("unexpected schema (-expected +actual):\n%s", diff)
+		}
+	})
+}
+
+func TestSchemaFor_roundTrip(t *testing.T) {
+	descriptor := (&testdatav1.KitchenSink{}).ProtoReflect().Descriptor()
+	schema, err := SchemaFor(descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues := CheckCompatibility(schema, descriptor); len(issues) != 0 {
+		t.Fatalf("expected generated schema to be compatible, got %v", issues)
+	}
+	packed, err := anypb.New(wrapperspb.String("packed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]bigquery.Value{
+		"decimal_value":    big.NewRat(314, 100),
+		"price":            big.NewRat(5, 1),
+		"price_currency":   "USD",
+		"interval_value":   &bigquery.IntervalValue{Hours: 1, Minutes: 30},
+		"route":            "LINESTRING(1 2, 3 4)",
+		"timestamp_millis": int64(1500),
+		"any_value":        []bigquery.Value{packed.GetTypeUrl(), packed.GetValue()},
+		"null_value":       "null",
+	}
+	row := make([]bigquery.Value, len(schema))
+	for i, fieldSchema := range schema {
+		if value, ok := values[fieldSchema.Name]; ok {
+			row[i] = value
+		} else if fieldSchema.Repeated {
+			row[i] = []bigquery.Value{}
+		}
+	}
+	loader := MessageLoader{Message: &testdatav1.KitchenSink{}}
+	if err := loader.Load(row, schema); err != nil {
+		t.Fatal(err)
+	}
+	var interval testdatav1.Interval
+	interval.SetYears(0)
+	interval.SetMonths(0)
+	interval.SetDays(0)
+	interval.SetHours(1)
+	interval.SetMinutes(30)
+	interval.SetSeconds(0)
+	interval.SetNanos(0)
+	var route testdatav1.Route
+	route.SetWaypoints([]*latlng.LatLng{{Latitude: 2, Longitude: 1}, {Latitude: 4, Longitude: 3}})
+	var expected testdatav1.KitchenSink
+	expected.SetDecimalValue(&decimal.Decimal{Value: "3.14"})
+	expected.SetPrice(&money.Money{CurrencyCode: "USD", Units: 5})
+	expected.SetIntervalValue(&interval)
+	expected.SetRoute(&route)
+	expected.SetTimestampMillis(&timestamppb.Timestamp{Seconds: 1, Nanos: 5e8})
+	expected.SetAnyValue(packed)
+	expected.SetNullValue(structpb.NullValue_NULL_VALUE)
+	if diff := cmp.Diff(&expected, loader.Message, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+	}
+}
+
+func TestSchemaFor_recursive(t *testing.T) {
+	descriptor := (&descriptorpb.DescriptorProto{}).ProtoReflect().Descriptor()
+	t.Run("default", func(t *testing.T) {
+		_, err := SchemaFor(descriptor)
+		if err == nil || !strings.Contains(err.Error(), "unsupported recursive message") {
+			t.Errorf("expected recursive message error, got %v", err)
+		}
+	})
+	t.Run("max recursion depth", func(t *testing.T) {
+		schema, err := SchemaFor(descriptor, WithMaxRecursionDepth(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nestedType := findFieldSchema(schema, "nested_type")
+		if nestedType == nil {
+			t.Fatal("expected nested_type field")
+		}
+		if !nestedType.Repeated || nestedType.Type != bigquery.RecordFieldType {
+			t.Errorf("expected nested_type to be a repeated record, got %v", nestedType)
+		}
+		if findFieldSchema(nestedType.Schema, "nested_type") != nil {
+			t.Errorf("expected nested_type to be omitted beyond max recursion depth")
+		}
+	})
+}
+
+func findFieldSchema(schema bigquery.Schema, name string) *bigquery.FieldSchema {
+	for _, fieldSchema := range schema {
+		if fieldSchema.Name == name {
+			return fieldSchema
+		}
+	}
+	return nil
+}
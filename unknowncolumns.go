@@ -0,0 +1,182 @@
+package protobq
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// unknownColumnsField returns the field storing the unknown columns of the message,
+// as set by the field option (protobq.v1.field).unknown_columns, or nil.
+func unknownColumnsField(message protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	fields := message.Fields()
+	for i := range fields.Len() {
+		if field := fields.Get(i); fieldOptions(field).GetUnknownColumns() {
+			return field
+		}
+	}
+	return nil
+}
+
+// checkUnknownColumnsField returns an error if the field can not store unknown columns.
+func checkUnknownColumnsField(field protoreflect.FieldDescriptor) *LoadError {
+	switch {
+	case field.IsMap():
+		if field.MapKey().Kind() == protoreflect.StringKind && field.MapValue().Kind() == protoreflect.StringKind {
+			return nil
+		}
+	case field.IsList():
+	case field.Message() != nil && field.Message().FullName() == wktStruct:
+		return nil
+	}
+	err := newLoadError(
+		ErrUnsupportedType, nil, "unknown columns field %s is not a %s or map<string, string>", field.FullName(), wktStruct,
+	)
+	return wrapLoadError(err, string(field.Name()), nil, field).(*LoadError)
+}
+
+// setUnknownColumn stores an unknown column in the field, as a JSON object holding its BigQuery type and value.
+func setUnknownColumn(
+	bqField bigquery.Value,
+	bqFieldSchema *bigquery.FieldSchema,
+	field protoreflect.FieldDescriptor,
+	message protoreflect.Message,
+) error {
+	value, err := columnJSONValue(bqField, bqFieldSchema, bqFieldSchema.Repeated)
+	if err != nil {
+		return err
+	}
+	column := map[string]any{"type": string(bqFieldSchema.Type), "value": value}
+	if bqFieldSchema.Repeated {
+		column["repeated"] = true
+	}
+	if field.IsMap() {
+		data, err := json.Marshal(column)
+		if err != nil {
+			return newLoadError(ErrInvalidValue, bqField, "%w", err)
+		}
+		message.Mutable(field).Map().Set(
+			protoreflect.ValueOfString(bqFieldSchema.Name).MapKey(), protoreflect.ValueOfString(string(data)),
+		)
+		return nil
+	}
+	structValue, ok := message.Mutable(field).Message().Interface().(*structpb.Struct)
+	if !ok {
+		return newLoadError(ErrUnsupportedType, bqField, "unsupported message type for %s", wktStruct)
+	}
+	columnValue, err := structpb.NewValue(column)
+	if err != nil {
+		return newLoadError(ErrInvalidValue, bqField, "%w", err)
+	}
+	if structValue.Fields == nil {
+		structValue.Fields = make(map[string]*structpb.Value)
+	}
+	structValue.Fields[bqFieldSchema.Name] = columnValue
+	return nil
+}
+
+// columnJSONValue converts a BigQuery value to the types supported by structpb.NewValue,
+// following the JSON mapping of protobuf: INTEGER values as strings, BYTES values in base64,
+// and non-finite FLOAT values as "NaN", "Infinity" and "-Infinity".
+func columnJSONValue(bqValue bigquery.Value, bqFieldSchema *bigquery.FieldSchema, repeated bool) (any, error) {
+	if repeated {
+		bqList, ok := bqValue.([]bigquery.Value)
+		if !ok {
+			return nil, newLoadError(ErrTypeMismatch, bqValue, "unsupported BigQuery value for REPEATED column: %#v", bqValue)
+		}
+		result := make([]any, len(bqList))
+		for i, bqElement := range bqList {
+			element, err := columnJSONValue(bqElement, bqFieldSchema, false)
+			if err != nil {
+				return nil, wrapLoadError(err, indexSegment(i), nil, nil)
+			}
+			result[i] = element
+		}
+		return result, nil
+	}
+	switch v := bqValue.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if bqFieldSchema.Type == bigquery.JSONFieldType {
+			var result any
+			if err := json.Unmarshal([]byte(v), &result); err == nil {
+				return result, nil
+			}
+		}
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			return "NaN", nil
+		case math.IsInf(v, 1):
+			return "Infinity", nil
+		case math.IsInf(v, -1):
+			return "-Infinity", nil
+		}
+		return v, nil
+	case bool:
+		return v, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case civil.Date:
+		return v.String(), nil
+	case civil.Time:
+		return v.String(), nil
+	case civil.DateTime:
+		return v.String(), nil
+	case *big.Rat:
+		result, err := numericDecimalString(v)
+		if err != nil {
+			return nil, newLoadError(ErrInvalidValue, bqValue, "%w", err)
+		}
+		return result, nil
+	case *bigquery.IntervalValue:
+		return v.String(), nil
+	case *bigquery.RangeValue:
+		var elementSchema bigquery.FieldSchema
+		if bqFieldSchema.RangeElementType != nil {
+			elementSchema.Type = bqFieldSchema.RangeElementType.Type
+		}
+		start, err := columnJSONValue(v.Start, &elementSchema, false)
+		if err != nil {
+			return nil, err
+		}
+		end, err := columnJSONValue(v.End, &elementSchema, false)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"start": start, "end": end}, nil
+	case []bigquery.Value:
+		if len(v) != len(bqFieldSchema.Schema) {
+			return nil, newLoadError(
+				ErrSchemaMismatch, bqValue, "record has %d fields but schema has %d fields", len(v), len(bqFieldSchema.Schema),
+			)
+		}
+		result := make(map[string]any, len(v))
+		for i, bqNestedSchema := range bqFieldSchema.Schema {
+			value, err := columnJSONValue(v[i], bqNestedSchema, bqNestedSchema.Repeated)
+			if err != nil {
+				return nil, wrapLoadError(err, bqNestedSchema.Name, bqNestedSchema, nil)
+			}
+			result[bqNestedSchema.Name] = value
+		}
+		return result, nil
+	case map[string]bigquery.Value, []any, map[string]any:
+		return decodedJSONValue(v), nil
+	default:
+		return nil, newLoadError(ErrUnsupportedType, bqValue, "unsupported BigQuery value for unknown column: %#v", bqValue)
+	}
+}
@@ -0,0 +1,143 @@
+package protobq
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/google/go-cmp/cmp"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMessageLoader_unknownColumns(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "id", Type: bigquery.StringFieldType},
+		{Name: "count", Type: bigquery.IntegerFieldType},
+		{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+		{Name: "price", Type: bigquery.NumericFieldType},
+		{Name: "ratio", Type: bigquery.FloatFieldType},
+		{Name: "created", Type: bigquery.TimestampFieldType},
+		{Name: "day", Type: bigquery.DateFieldType},
+		{Name: "payload", Type: bigquery.JSONFieldType},
+		{Name: "data", Type: bigquery.BytesFieldType},
+		{Name: "missing", Type: bigquery.BooleanFieldType},
+		{
+			Name:   "info",
+			Type:   bigquery.RecordFieldType,
+			Schema: bigquery.Schema{{Name: "number", Type: bigquery.IntegerFieldType}},
+		},
+		{
+			Name: "nested",
+			Type: bigquery.RecordFieldType,
+			Schema: bigquery.Schema{
+				{Name: "id", Type: bigquery.StringFieldType},
+				{Name: "flag", Type: bigquery.BooleanFieldType},
+			},
+		},
+	}
+	row := []bigquery.Value{
+		"a",
+		int64(math.MaxInt64),
+		[]bigquery.Value{"x", "y"},
+		big.NewRat(314, 100),
+		math.Inf(1),
+		time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+		civil.Date{Year: 2024, Month: time.March, Day: 1},
+		`{"b":[1,true]}`,
+		[]byte("hi"),
+		nil,
+		[]bigquery.Value{int64(7)},
+		[]bigquery.Value{"n", true},
+	}
+	newExtra := func(t *testing.T, columns map[string]any) *structpb.Struct {
+		result, err := structpb.NewStruct(columns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	t.Run("Struct", func(t *testing.T) {
+		loader := MessageLoader{Message: &testdatav1.UnknownColumnsStruct{}}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		var nested testdatav1.UnknownColumnsMap
+		nested.SetId("n")
+		nested.SetExtra(map[string]string{"flag": `{"type":"BOOLEAN","value":true}`})
+		var expected testdatav1.UnknownColumnsStruct
+		expected.SetId("a")
+		expected.SetNested(&nested)
+		expected.SetExtra(newExtra(t, map[string]any{
+			"count":   map[string]any{"type": "INTEGER", "value": "9223372036854775807"},
+			"tags":    map[string]any{"type": "STRING", "repeated": true, "value": []any{"x", "y"}},
+			"price":   map[string]any{"type": "NUMERIC", "value": "3.14"},
+			"ratio":   map[string]any{"type": "FLOAT", "value": "Infinity"},
+			"created": map[string]any{"type": "TIMESTAMP", "value": "2024-03-01T12:00:00Z"},
+			"day":     map[string]any{"type": "DATE", "value": "2024-03-01"},
+			"payload": map[string]any{"type": "JSON", "value": map[string]any{"b": []any{1, true}}},
+			"data":    map[string]any{"type": "BYTES", "value": "aGk="},
+			"missing": map[string]any{"type": "BOOLEAN", "value": nil},
+			"info":    map[string]any{"type": "RECORD", "value": map[string]any{"number": "7"}},
+		}))
+		if diff := cmp.Diff(&expected, loader.Message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("masked", func(t *testing.T) {
+		loader := MessageLoader{
+			Message:   &testdatav1.UnknownColumnsStruct{},
+			FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"id"}},
+		}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		if extra := loader.Message.(*testdatav1.UnknownColumnsStruct).GetExtra(); extra != nil {
+			t.Errorf("expected no unknown columns, got %v", extra)
+		}
+		loader.FieldMask.Paths = []string{"id", "extra"}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		if fields := loader.Message.(*testdatav1.UnknownColumnsStruct).GetExtra().GetFields(); len(fields) != 10 {
+			t.Errorf("expected 10 unknown columns, got %d", len(fields))
+		}
+	})
+	t.Run("merged", func(t *testing.T) {
+		var message testdatav1.UnknownColumnsMap
+		message.SetExtra(map[string]string{"kept": "{}", "flag": "{}"})
+		loader := MessageLoader{Message: &message, Merge: true}
+		if err := loader.Load([]bigquery.Value{true}, schema[11].Schema[1:]); err != nil {
+			t.Fatal(err)
+		}
+		expected := map[string]string{"kept": "{}", "flag": `{"type":"BOOLEAN","value":true}`}
+		if diff := cmp.Diff(expected, message.GetExtra()); diff != "" {
+			t.Errorf("unexpected unknown columns (-expected +actual):\n%s", diff)
+		}
+	})
+	t.Run("unsupported value", func(t *testing.T) {
+		loader := MessageLoader{Message: &testdatav1.UnknownColumnsMap{}}
+		err := loader.Load([]bigquery.Value{struct{}{}}, bigquery.Schema{{Name: "other", Type: bigquery.StringFieldType}})
+		var loadErr *LoadError
+		if !errors.Is(err, ErrUnsupportedType) || !errors.As(err, &loadErr) || loadErr.Path != "other" {
+			t.Errorf("expected %v for other, got %v", ErrUnsupportedType, err)
+		}
+	})
+	t.Run("known columns only", func(t *testing.T) {
+		loader := MessageLoader{Message: &testdatav1.UnknownColumnsStruct{}}
+		if err := loader.Load(row[:1], schema[:1]); err != nil {
+			t.Fatal(err)
+		}
+		var expected testdatav1.UnknownColumnsStruct
+		expected.SetId("a")
+		if diff := cmp.Diff(&expected, loader.Message, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected message (-expected +actual):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package protobq
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Validator validates a loaded message, such as ValidateRules.
+// Violations of errors with a ToProto() *validate.Violations method, such as *protovalidate.ValidationError,
+// are reported as LoadErrors with the paths of their columns.
+type Validator func(message proto.Message) error
+
+// ValidateRules validates messages with the protovalidate rules declared on their descriptor,
+// e.g. [(buf.validate.field).string.min_len = 1].
+func ValidateRules(message proto.Message) error {
+	return protovalidate.Validate(message)
+}
+
+// violationsError is implemented by the validation errors of protovalidate.
+type violationsError interface {
+	error
+	ToProto() *validate.Violations
+}
+
+// validate runs the Validator on the loaded message, converting its violations to LoadErrors.
+func (o *MessageLoader) validate(bqSchema bigquery.Schema) error {
+	err := o.Validator(o.Message)
+	if err == nil {
+		return nil
+	}
+	var violationsErr violationsError
+	if !errors.As(err, &violationsErr) {
+		return &LoadError{Kind: ErrValidation, Err: err}
+	}
+	violations := violationsErr.ToProto().GetViolations()
+	if len(violations) == 0 {
+		return &LoadError{Kind: ErrValidation, Err: err}
+	}
+	errs := make([]error, 0, len(violations))
+	for _, violation := range violations {
+		path, field := o.violationPath(bqSchema, o.Message.ProtoReflect().Descriptor(), violation)
+		loadErr := &LoadError{
+			Path: path,
+			Kind: ErrValidation,
+			Err:  fmt.Errorf("%s [%s]", violation.GetMessage(), violation.GetRuleId()),
+		}
+		if field != nil {
+			loadErr.Field = field.FullName()
+		}
+		errs = append(errs, loadErr)
+	}
+	return errors.Join(errs...)
+}
+
+// violationPath returns the column path and the field of a violation.
+// Fields without a column are identified by their proto name.
+func (o *MessageLoader) violationPath(
+	bqSchema bigquery.Schema,
+	message protoreflect.MessageDescriptor,
+	violation *validate.Violation,
+) (string, protoreflect.FieldDescriptor) {
+	var path strings.Builder
+	var field protoreflect.FieldDescriptor
+	elements := violation.GetField().GetElements()
+	for i, element := range elements {
+		if message == nil {
+			break
+		}
+		field = message.Fields().ByNumber(protoreflect.FieldNumber(element.GetFieldNumber()))
+		if field == nil {
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(element.GetFieldName())
+			break
+		}
+		name := string(field.Name())
+		var bqFieldSchema *bigquery.FieldSchema
+		for _, columnSchema := range bqSchema {
+			if o.resolveField(message, columnSchema.Name) == field {
+				name, bqFieldSchema = columnSchema.Name, columnSchema
+				break
+			}
+		}
+		if path.Len() > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(name)
+		message, bqSchema = field.Message(), nil
+		if bqFieldSchema != nil {
+			bqSchema = bqFieldSchema.Schema
+		}
+		switch element.WhichSubscript() {
+		case validate.FieldPathElement_Index_case:
+			path.WriteString(indexSegment(int(element.GetIndex())))
+		case validate.FieldPathElement_BoolKey_case:
+			fmt.Fprintf(&path, "[key=%v]", element.GetBoolKey())
+		case validate.FieldPathElement_IntKey_case:
+			fmt.Fprintf(&path, "[key=%v]", element.GetIntKey())
+		case validate.FieldPathElement_UintKey_case:
+			fmt.Fprintf(&path, "[key=%v]", element.GetUintKey())
+		case validate.FieldPathElement_StringKey_case:
+			fmt.Fprintf(&path, "[key=%q]", element.GetStringKey())
+		default:
+			continue
+		}
+		if !field.IsMap() {
+			continue
+		}
+		if i == len(elements)-1 && violation.GetForKey() {
+			path.WriteString(".key")
+			break
+		}
+		path.WriteString(".value")
+		message, bqSchema = field.MapValue().Message(), nil
+		if valueFieldSchema := mapValueFieldSchema(bqFieldSchema); valueFieldSchema != nil {
+			bqSchema = valueFieldSchema.Schema
+		}
+	}
+	return path.String(), field
+}
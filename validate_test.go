@@ -0,0 +1,335 @@
+package protobq
+
+import (
+	"errors"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"cloud.google.com/go/bigquery"
+	testdatav1 "github.com/way-platform/protobq-go/internal/gen/wayplatform/testdata/v1"
+	"github.com/way-platform/protobq-go/protobqpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testValidationError mimics the validation errors of protovalidate.
+type testValidationError struct {
+	violations *validate.Violations
+}
+
+func (e *testValidationError) Error() string {
+	return "validation error"
+}
+
+func (e *testValidationError) ToProto() *validate.Violations {
+	return e.violations
+}
+
+// newTestViolation returns a violation of the field path of the elements.
+func newTestViolation(forKey bool, elements ...*validate.FieldPathElement) *validate.Violation {
+	return validate.Violation_builder{
+		Field:   validate.FieldPath_builder{Elements: elements}.Build(),
+		RuleId:  proto.String("test.rule"),
+		Message: proto.String("value is invalid"),
+		ForKey:  proto.Bool(forKey),
+	}.Build()
+}
+
+func TestMessageLoader_validator(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "string_value", Type: bigquery.StringFieldType},
+	}
+	row := []bigquery.Value{"foo"}
+	element := func(number int32) *validate.FieldPathElement {
+		return validate.FieldPathElement_builder{FieldNumber: proto.Int32(number)}.Build()
+	}
+	indexElement := func(number int32, index uint64) *validate.FieldPathElement {
+		return validate.FieldPathElement_builder{FieldNumber: proto.Int32(number), Index: proto.Uint64(index)}.Build()
+	}
+	keyElement := func(number int32, key string) *validate.FieldPathElement {
+		return validate.FieldPathElement_builder{FieldNumber: proto.Int32(number), StringKey: proto.String(key)}.Build()
+	}
+	nestedSchema := bigquery.Schema{{Name: "number", Type: bigquery.IntegerFieldType}}
+	pathSchema := bigquery.Schema{
+		{Name: "nested_message", Type: bigquery.RecordFieldType, Schema: nestedSchema},
+		{Name: "repeated_nested", Type: bigquery.RecordFieldType, Repeated: true, Schema: nestedSchema},
+		{
+			Name:     "map_string_nested",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema: bigquery.Schema{
+				{Name: "key", Type: bigquery.StringFieldType},
+				{Name: "value", Type: bigquery.RecordFieldType, Schema: nestedSchema},
+			},
+		},
+	}
+	for _, test := range []struct {
+		name          string
+		schema        bigquery.Schema
+		violation     *validate.Violation
+		expectedPath  string
+		expectedField protoreflect.FullName
+	}{
+		{
+			name:          "message",
+			schema:        schema,
+			violation:     newTestViolation(false),
+			expectedPath:  "",
+			expectedField: "",
+		},
+		{
+			name:          "field",
+			schema:        schema,
+			violation:     newTestViolation(false, element(14)),
+			expectedPath:  "string_value",
+			expectedField: "wayplatform.testdata.v1.KitchenSink.string_value",
+		},
+		{
+			name:          "field without column",
+			schema:        schema,
+			violation:     newTestViolation(false, element(13)),
+			expectedPath:  "bool_value",
+			expectedField: "wayplatform.testdata.v1.KitchenSink.bool_value",
+		},
+		{
+			name:          "nested field",
+			schema:        pathSchema,
+			violation:     newTestViolation(false, element(20), element(2)),
+			expectedPath:  "nested_message.number",
+			expectedField: "wayplatform.testdata.v1.NestedMessage.number",
+		},
+		{
+			name:          "list element field",
+			schema:        pathSchema,
+			violation:     newTestViolation(false, indexElement(19, 3), element(2)),
+			expectedPath:  "repeated_nested[3].number",
+			expectedField: "wayplatform.testdata.v1.NestedMessage.number",
+		},
+		{
+			name:          "map value field",
+			schema:        pathSchema,
+			violation:     newTestViolation(false, keyElement(24, "a"), element(2)),
+			expectedPath:  `map_string_nested[key="a"].value.number`,
+			expectedField: "wayplatform.testdata.v1.NestedMessage.number",
+		},
+		{
+			name:          "map key",
+			schema:        pathSchema,
+			violation:     newTestViolation(true, keyElement(24, "a")),
+			expectedPath:  `map_string_nested[key="a"].key`,
+			expectedField: "wayplatform.testdata.v1.KitchenSink.map_string_nested",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loader := MessageLoader{
+				Message: &testdatav1.KitchenSink{},
+				Validator: func(proto.Message) error {
+					violations := validate.Violations_builder{Violations: []*validate.Violation{test.violation}}.Build()
+					return &testValidationError{violations: violations}
+				},
+			}
+			emptyRow := make([]bigquery.Value, len(test.schema))
+			for i, bqFieldSchema := range test.schema {
+				if bqFieldSchema.Repeated {
+					emptyRow[i] = []bigquery.Value{}
+				}
+			}
+			err := loader.Load(emptyRow, test.schema)
+			var loadErr *LoadError
+			if !errors.Is(err, ErrValidation) || !errors.As(err, &loadErr) {
+				t.Fatalf("expected %v, got %v", ErrValidation, err)
+			}
+			if loadErr.Path != test.expectedPath {
+				t.Errorf("expected path %q, got %q", test.expectedPath, loadErr.Path)
+			}
+			if loadErr.Field != test.expectedField {
+				t.Errorf("expected field %q, got %q", test.expectedField, loadErr.Field)
+			}
+		})
+	}
+
+	t.Run("renamed column", func(t *testing.T) {
+		loader := MessageLoader{
+			Message:       &testdatav1.RenamedFields{},
+			FieldResolver: ResolveFieldByColumnOption,
+			Validator: func(proto.Message) error {
+				violations := validate.Violations_builder{
+					Violations: []*validate.Violation{newTestViolation(false, element(3)), newTestViolation(false, element(1))},
+				}.Build()
+				return &testValidationError{violations: violations}
+			},
+		}
+		err := loader.Load([]bigquery.Value{int64(-1)}, bigquery.Schema{{Name: "uid", Type: bigquery.IntegerFieldType}})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		var paths []string
+		for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+			var loadErr *LoadError
+			if errors.As(err, &loadErr) {
+				paths = append(paths, loadErr.Path)
+			}
+		}
+		if len(paths) != 2 || paths[0] != "uid" || paths[1] != "display_name" {
+			t.Errorf("expected paths [uid display_name], got %v", paths)
+		}
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		validationErr := errors.New("compilation error")
+		loader := MessageLoader{
+			Message:   &testdatav1.KitchenSink{},
+			Validator: func(proto.Message) error { return validationErr },
+		}
+		err := loader.Load(row, schema)
+		if !errors.Is(err, ErrValidation) || !errors.Is(err, validationErr) {
+			t.Errorf("expected %v, got %v", validationErr, err)
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		var validated proto.Message
+		loader := MessageLoader{
+			Message: &testdatav1.KitchenSink{},
+			Validator: func(message proto.Message) error {
+				validated = message
+				return nil
+			},
+		}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+		if validated != loader.Message {
+			t.Error("expected loaded message to be validated")
+		}
+	})
+}
+
+// newConstrainedMessageType returns the type of an Order message with protovalidate rules:
+//
+//	message Order {
+//	  string id = 1 [(buf.validate.field).string.min_len = 1, (protobq.v1.field).column = "order_id"];
+//	  repeated Item items = 2;
+//	}
+//
+//	message Item {
+//	  int64 quantity = 1 [(buf.validate.field).int64.gt = 0];
+//	}
+func newConstrainedMessageType(t *testing.T) protoreflect.MessageType {
+	t.Helper()
+	idOptions := &descriptorpb.FieldOptions{}
+	proto.SetExtension(idOptions, validate.E_Field, validate.FieldRules_builder{
+		String: validate.StringRules_builder{MinLen: proto.Uint64(1)}.Build(),
+	}.Build())
+	proto.SetExtension(idOptions, protobqpb.E_Field, protobqpb.FieldOptions_builder{
+		Column: proto.String("order_id"),
+	}.Build())
+	quantityOptions := &descriptorpb.FieldOptions{}
+	proto.SetExtension(quantityOptions, validate.E_Field, validate.FieldRules_builder{
+		Int64: validate.Int64Rules_builder{Gt: proto.Int64(0)}.Build(),
+	}.Build())
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("protobq/test/v1/order.proto"),
+		Package:    proto.String("protobq.test.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"buf/validate/validate.proto", "protobq/v1/annotations.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Order"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("id"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						JsonName: proto.String("id"),
+						Options:  idOptions,
+					},
+					{
+						Name:     proto.String("items"),
+						Number:   proto.Int32(2),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".protobq.test.v1.Item"),
+						JsonName: proto.String("items"),
+					},
+				},
+			},
+			{
+				Name: proto.String("Item"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("quantity"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+						JsonName: proto.String("quantity"),
+						Options:  quantityOptions,
+					},
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dynamicpb.NewMessageType(file.Messages().ByName("Order"))
+}
+
+func TestValidateRules(t *testing.T) {
+	messageType := newConstrainedMessageType(t)
+	schema := bigquery.Schema{
+		{Name: "order_id", Type: bigquery.StringFieldType},
+		{
+			Name:     "items",
+			Type:     bigquery.RecordFieldType,
+			Repeated: true,
+			Schema:   bigquery.Schema{{Name: "quantity", Type: bigquery.IntegerFieldType}},
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		loader := MessageLoader{Message: messageType.New().Interface(), Validator: ValidateRules}
+		row := []bigquery.Value{"o-1", []bigquery.Value{[]bigquery.Value{int64(2)}}}
+		if err := loader.Load(row, schema); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		loader := MessageLoader{Message: messageType.New().Interface(), Validator: ValidateRules}
+		row := []bigquery.Value{"", []bigquery.Value{[]bigquery.Value{int64(2)}, []bigquery.Value{int64(0)}}}
+		err := loader.Load(row, schema)
+		if !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected %v, got %v", ErrValidation, err)
+		}
+		type violation struct {
+			path  string
+			field protoreflect.FullName
+		}
+		var violations []violation
+		for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+			var loadErr *LoadError
+			if !errors.As(err, &loadErr) {
+				t.Fatalf("expected *LoadError, got %v", err)
+			}
+			violations = append(violations, violation{path: loadErr.Path, field: loadErr.Field})
+		}
+		expected := []violation{
+			{path: "order_id", field: "protobq.test.v1.Order.id"},
+			{path: "items[1].quantity", field: "protobq.test.v1.Item.quantity"},
+		}
+		if len(violations) != len(expected) {
+			t.Fatalf("expected violations %v, got %v", expected, violations)
+		}
+		for i := range expected {
+			if violations[i] != expected[i] {
+				t.Errorf("expected violation %v, got %v", expected[i], violations[i])
+			}
+		}
+	})
+}